@@ -0,0 +1,120 @@
+package conventionalcommits
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ReleaseLink is a link of a release announcement (eg., to the changelog, or to the release page).
+type ReleaseLink struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// ReleaseSummary is the announcement of a release.
+type ReleaseSummary struct {
+	Version string `json:"version"`
+	// Highlights are the entries worth announcing (eg., the features).
+	Highlights []ChangelogEntry `json:"highlights"`
+	Breaking   []ChangelogEntry `json:"breaking"`
+	Links      []ReleaseLink    `json:"links"`
+}
+
+// Summary summarizes the receiving manifest for an announcement:
+// its features and its hand-written entries are the highlights, apart from its breaking changes.
+func (m ChangelogManifest) Summary(links ...ReleaseLink) ReleaseSummary {
+	s := ReleaseSummary{Version: m.Version, Highlights: []ChangelogEntry{}, Breaking: []ChangelogEntry{}, Links: append([]ReleaseLink{}, links...)}
+	for _, e := range m.Entries {
+		switch {
+		case e.Breaking:
+			s.Breaking = append(s.Breaking, e)
+		case e.Type == "feat" || e.Section != "":
+			s.Highlights = append(s.Highlights, e)
+		}
+	}
+
+	return s
+}
+
+// slackEscaper escapes the control characters of the Slack text formatting.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackList renders the given entries as a list in the Slack text formatting, with their scope in bold.
+func slackList(entries []ChangelogEntry) string {
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		text := slackEscaper.Replace(e.Text)
+		if e.Scope != "" {
+			text = "*" + slackEscaper.Replace(e.Scope) + ":* " + text
+		}
+		lines[i] = "• " + text
+	}
+	return strings.Join(lines, "\n")
+}
+
+// SlackMessage renders the receiving summary as the JSON payload of a Slack message made of Block Kit blocks,
+// ready to post to an incoming webhook, or with the chat.postMessage method.
+func (s ReleaseSummary) SlackMessage() ([]byte, error) {
+	blocks := []interface{}{
+		map[string]interface{}{"type": "header", "text": map[string]interface{}{"type": "plain_text", "text": "Release " + s.Version}},
+	}
+	section := func(title string, entries []ChangelogEntry) {
+		if len(entries) > 0 {
+			blocks = append(blocks, map[string]interface{}{"type": "section", "text": map[string]interface{}{"type": "mrkdwn", "text": "*" + title + "*\n" + slackList(entries)}})
+		}
+	}
+	section("Highlights", s.Highlights)
+	section("Breaking changes", s.Breaking)
+	if len(s.Links) > 0 {
+		links := make([]string, len(s.Links))
+		for i, l := range s.Links {
+			links[i] = "<" + l.URL + "|" + slackEscaper.Replace(l.Title) + ">"
+		}
+		blocks = append(blocks, map[string]interface{}{"type": "context", "elements": []interface{}{map[string]interface{}{"type": "mrkdwn", "text": strings.Join(links, " · ")}}})
+	}
+
+	return json.Marshal(map[string]interface{}{"text": "Release " + s.Version, "blocks": blocks})
+}
+
+// TeamsMessage renders the receiving summary as the JSON payload of a Microsoft Teams message with an Adaptive Card,
+// ready to post to an incoming webhook.
+func (s ReleaseSummary) TeamsMessage() ([]byte, error) {
+	body := []interface{}{
+		map[string]interface{}{"type": "TextBlock", "text": "Release " + s.Version, "size": "Large", "weight": "Bolder", "wrap": true},
+	}
+	section := func(title string, entries []ChangelogEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		lines := make([]string, len(entries))
+		for i, e := range entries {
+			// The sections tell the breaking changes apart
+			e.Breaking = false
+			lines[i] = "- " + markdownEntry(e)
+		}
+		body = append(body,
+			map[string]interface{}{"type": "TextBlock", "text": title, "weight": "Bolder", "spacing": "Medium", "wrap": true},
+			map[string]interface{}{"type": "TextBlock", "text": strings.Join(lines, "\n"), "wrap": true},
+		)
+	}
+	section("Highlights", s.Highlights)
+	section("Breaking changes", s.Breaking)
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if len(s.Links) > 0 {
+		actions := make([]interface{}, len(s.Links))
+		for i, l := range s.Links {
+			actions[i] = map[string]interface{}{"type": "Action.OpenUrl", "title": l.Title, "url": l.URL}
+		}
+		card["actions"] = actions
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"type":        "message",
+		"attachments": []interface{}{map[string]interface{}{"contentType": "application/vnd.microsoft.card.adaptive", "content": card}},
+	})
+}
@@ -0,0 +1,122 @@
+package conventionalcommits
+
+import (
+	"encoding/xml"
+	"html"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReleaseFeed describes the Atom feed of the releases of a project.
+type ReleaseFeed struct {
+	// ID identifies the feed permanently (eg., the URL of the releases page).
+	ID    string
+	Title string
+	// Link is the URL of the releases page, if any.
+	Link   string
+	Author string
+}
+
+// FeedRelease is a release in an Atom feed, built from its changelog manifest.
+type FeedRelease struct {
+	Manifest ChangelogManifest
+	Date     time.Time
+	// Link is the URL of the release page, if any.
+	Link string
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Content atomContent `xml:"content"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// Atom renders the Atom feed of the given releases, from the newest one.
+//
+// The content of every entry is the changelog of the release in HTML, grouped by type (see GroupChangelogEntries).
+// The entries without a link get the ID of the feed, followed by their version as fragment, as ID.
+func (f ReleaseFeed) Atom(releases ...FeedRelease) ([]byte, error) {
+	releases = append([]FeedRelease{}, releases...)
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].Date.After(releases[j].Date)
+	})
+
+	feed := atomFeed{ID: f.ID, Title: f.Title, Entries: []atomEntry{}}
+	if f.Link != "" {
+		feed.Link = &atomLink{Href: f.Link}
+	}
+	if f.Author != "" {
+		feed.Author = &atomAuthor{Name: f.Author}
+	}
+	if len(releases) > 0 {
+		feed.Updated = releases[0].Date.UTC().Format(time.RFC3339)
+	} else {
+		feed.Updated = time.Unix(0, 0).UTC().Format(time.RFC3339)
+	}
+	for _, r := range releases {
+		e := atomEntry{
+			ID:      f.ID + "#" + r.Manifest.Version,
+			Title:   r.Manifest.Version,
+			Updated: r.Date.UTC().Format(time.RFC3339),
+			Content: atomContent{Type: "html", Body: htmlChangelog(r.Manifest.Entries)},
+		}
+		if r.Link != "" {
+			e.ID = r.Link
+			e.Link = &atomLink{Href: r.Link}
+		}
+		feed.Entries = append(feed.Entries, e)
+	}
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+// htmlChangelog renders the given changelog entries in HTML, with a heading for every group of entries.
+func htmlChangelog(entries []ChangelogEntry) string {
+	var b strings.Builder
+	for _, g := range GroupChangelogEntries(entries) {
+		b.WriteString("<h3>" + html.EscapeString(g.Title) + "</h3><ul>")
+		for _, e := range g.Entries {
+			b.WriteString("<li>")
+			if e.Scope != "" {
+				b.WriteString("<strong>" + html.EscapeString(e.Scope) + ":</strong> ")
+			}
+			b.WriteString(html.EscapeString(e.Text))
+			if e.Breaking {
+				b.WriteString(" (breaking)")
+			}
+			b.WriteString("</li>")
+		}
+		b.WriteString("</ul>")
+	}
+	return b.String()
+}
@@ -0,0 +1,136 @@
+package conventionalcommits
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Baseline records the known violations of the existing history.
+//
+// It lets the checks grandfather the legacy commits while enforcing the rules strictly on the new ones.
+// Every entry pairs a commit identifier (eg., its hash) with a diagnostic code.
+type Baseline struct {
+	entries map[string]map[string]bool
+}
+
+// NewBaseline creates an empty baseline.
+func NewBaseline() *Baseline {
+	return &Baseline{entries: map[string]map[string]bool{}}
+}
+
+// Add records the given diagnostics of the commit.
+func (b *Baseline) Add(commit string, diagnostics ...Diagnostic) {
+	for _, d := range diagnostics {
+		if b.entries[commit] == nil {
+			b.entries[commit] = map[string]bool{}
+		}
+		b.entries[commit][d.Code] = true
+	}
+}
+
+// Len returns the number of entries of the baseline.
+func (b *Baseline) Len() int {
+	n := 0
+	for _, codes := range b.entries {
+		n += len(codes)
+	}
+
+	return n
+}
+
+// Contains tells whether the baseline grandfathers the diagnostic of the commit.
+func (b *Baseline) Contains(commit string, d Diagnostic) bool {
+	return b.entries[commit][d.Code]
+}
+
+// Filter returns the diagnostics of the commit the baseline does not grandfather.
+func (b *Baseline) Filter(commit string, diagnostics []Diagnostic) []Diagnostic {
+	res := []Diagnostic{}
+	for _, d := range diagnostics {
+		if !b.Contains(commit, d) {
+			res = append(res, d)
+		}
+	}
+
+	return res
+}
+
+// Shrink removes the entries no longer matching the current diagnostics of the commits.
+//
+// Commits missing from the given map are left untouched.
+// It returns the number of removed entries.
+func (b *Baseline) Shrink(current map[string][]Diagnostic) int {
+	removed := 0
+	for commit, diagnostics := range current {
+		codes, ok := b.entries[commit]
+		if !ok {
+			continue
+		}
+		still := map[string]bool{}
+		for _, d := range diagnostics {
+			still[d.Code] = true
+		}
+		for code := range codes {
+			if !still[code] {
+				delete(codes, code)
+				removed++
+			}
+		}
+		if len(codes) == 0 {
+			delete(b.entries, commit)
+		}
+	}
+
+	return removed
+}
+
+// WriteTo writes the baseline, one sorted "<commit> <code>" entry per line.
+func (b *Baseline) WriteTo(w io.Writer) (int64, error) {
+	lines := []string{}
+	for commit, codes := range b.entries {
+		for code := range codes {
+			lines = append(lines, commit+" "+code)
+		}
+	}
+	sort.Strings(lines)
+
+	var n int64
+	for _, l := range lines {
+		c, err := io.WriteString(w, l+"\n")
+		n += int64(c)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// ReadBaseline reads a baseline written by WriteTo.
+//
+// Blank lines and lines starting with '#' are ignored.
+func ReadBaseline(r io.Reader) (*Baseline, error) {
+	b := NewBaseline()
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed baseline entry at line %d", line)
+		}
+		b.Add(fields[0], Diagnostic{Code: fields[1]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,42 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// BranchPolicy restricts the types of the commits targeting the branches matching a pattern.
+//
+// The pattern uses the syntax of path.Match (eg., "release/*").
+type BranchPolicy struct {
+	Pattern string
+	Types   []string
+}
+
+// BranchPolicies is a list of branch policies.
+//
+// The first policy matching a branch applies to it.
+type BranchPolicies []BranchPolicy
+
+// Check tells whether the given commit message can target the given branch.
+//
+// The branches no policy matches accept any type.
+func (p BranchPolicies) Check(branch string, c *ConventionalCommit) error {
+	branch = strings.TrimPrefix(branch, "refs/heads/")
+	for _, policy := range p {
+		matched, err := path.Match(policy.Pattern, branch)
+		if err != nil {
+			return fmt.Errorf("invalid branch pattern '%s': %w", policy.Pattern, err)
+		}
+		if !matched {
+			continue
+		}
+		if TypeIs(policy.Types...)(c) {
+			return nil
+		}
+		return fmt.Errorf("type '%s' not allowed into branch '%s': expecting one of %s", c.Type, branch, strings.Join(policy.Types, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package conventionalcommits
+
+// Capability represents a versioned feature of this module.
+type Capability struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// CapabilitySet lists the features of this module, so that consumers can detect them at runtime.
+type CapabilitySet struct {
+	// Specs lists the supported specification versions.
+	Specs []Capability `json:"specs"`
+	// Presets lists the sets of commit message types.
+	Presets []Capability `json:"presets"`
+	// Rules lists the checks the parser can enforce.
+	Rules []Capability `json:"rules"`
+	// Formats lists the formats the parse results can be encoded to.
+	Formats []Capability `json:"formats"`
+}
+
+// Has tells whether the receiving set contains the capability with the given name, whatever its kind.
+func (s CapabilitySet) Has(name string) bool {
+	for _, list := range [][]Capability{s.Specs, s.Presets, s.Rules, s.Formats} {
+		for _, c := range list {
+			if c.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var capabilities = CapabilitySet{
+	Specs: []Capability{
+		{Name: "conventionalcommits", Version: "1.0.0"},
+	},
+	Presets: []Capability{
+		{Name: "minimal", Version: "1"},
+		{Name: "conventional", Version: "1"},
+		{Name: "free-form", Version: "1"},
+		{Name: "registry", Version: "1"},
+		{Name: "custom-types", Version: "1"},
+	},
+	Rules: []Capability{
+		{Name: "best-effort", Version: "1"},
+		{Name: "case-insensitive-types", Version: "1"},
+		{Name: "type-pattern", Version: "1"},
+		{Name: "header-limit", Version: "1"},
+		{Name: "rewind-budget", Version: "1"},
+		{Name: "required-scope", Version: "1"},
+		{Name: "no-scope-whitespace", Version: "1"},
+		{Name: "multiple-scopes", Version: "1"},
+		{Name: "scope-path", Version: "1"},
+		{Name: "scope-normalization", Version: "1"},
+		{Name: "trivial-description", Version: "1"},
+		{Name: "max-header-length", Version: "1"},
+		{Name: "no-trailing-period", Version: "1"},
+		{Name: "description-case", Version: "1"},
+		{Name: "style-warnings", Version: "1"},
+		{Name: "duplicate-footer-policy", Version: "1"},
+		{Name: "required-change-id", Version: "1"},
+		{Name: "allowed-footers", Version: "1"},
+		{Name: "strict-spec", Version: "1"},
+		{Name: "lenient-colon-space", Version: "1"},
+		{Name: "lenient-blank-line", Version: "1"},
+		{Name: "trim-trailing-whitespace", Version: "1"},
+		{Name: "skip-bom", Version: "1"},
+		{Name: "description-separator", Version: "1"},
+		{Name: "multiline-footers", Version: "1"},
+		{Name: "footer-key-case", Version: "1"},
+	},
+	Formats: []Capability{
+		{Name: "ndjson", Version: "1"},
+		{Name: "yaml", Version: "1"},
+		{Name: "protobuf", Version: "1"},
+		{Name: "node", Version: "1"},
+		{Name: "git-trailers", Version: "1"},
+	},
+}
+
+// Capabilities returns the features this module supports.
+func Capabilities() CapabilitySet {
+	return CapabilitySet{
+		Specs:   append([]Capability{}, capabilities.Specs...),
+		Presets: append(append([]Capability{}, capabilities.Presets...), registeredPresets()...),
+		Rules:   append([]Capability{}, capabilities.Rules...),
+		Formats: append([]Capability{}, capabilities.Formats...),
+	}
+}
@@ -0,0 +1,26 @@
+package conventionalcommits
+
+import (
+	"regexp"
+)
+
+// changeIDRegexp matches the Change-Id of Gerrit.
+var changeIDRegexp = regexp.MustCompile(`^I[0-9a-f]{40}$`)
+
+// IsChangeID tells whether the given value is a valid Change-Id of Gerrit, that is "I" followed by 40 lowercase hexadecimal digits.
+func IsChangeID(value string) bool {
+	return changeIDRegexp.MatchString(value)
+}
+
+// ChangeID returns the Change-Id Gerrit tracks the revisions of a change with,
+// from the first valid "Change-Id" trailer of the receiving commit message.
+//
+// It returns false when there is none.
+func (c *ConventionalCommit) ChangeID() (string, bool) {
+	for _, v := range c.Footers["change-id"] {
+		if IsChangeID(v) {
+			return v, true
+		}
+	}
+	return "", false
+}
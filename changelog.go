@@ -0,0 +1,195 @@
+package conventionalcommits
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ChangelogEntry is an entry of a changelog manifest.
+type ChangelogEntry struct {
+	// ID identifies the entry across manifests (eg., the hash of its commit).
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Scope    string `json:"scope,omitempty"`
+	Breaking bool   `json:"breaking,omitempty"`
+	// Text is the release note of the commit, or else its description.
+	Text string `json:"text"`
+	// Section is the section of the changelog of the hand-written entries (eg., "Known issues"),
+	// while the entries of the commits go by type.
+	Section string `json:"section,omitempty"`
+}
+
+// Validate checks the receiving entry has an ID, a text, and either a type or a section.
+func (e ChangelogEntry) Validate() error {
+	switch {
+	case e.ID == "":
+		return fmt.Errorf("changelog entry without ID")
+	case e.Text == "":
+		return fmt.Errorf("changelog entry %q without text", e.ID)
+	case e.Type == "" && e.Section == "":
+		return fmt.Errorf("changelog entry %q without type nor section", e.ID)
+	}
+	return nil
+}
+
+// NewChangelogEntry creates the changelog entry of the given commit message, identified by id.
+//
+// It returns false when the commit deserves no changelog entry, because of a "NONE" release note.
+func NewChangelogEntry(id string, c *ConventionalCommit) (ChangelogEntry, bool) {
+	e := ChangelogEntry{ID: id, Type: c.Type, Breaking: c.IsBreakingChange(), Text: c.Description}
+	if c.Scope != nil {
+		e.Scope = *c.Scope
+	}
+	if note, ok := c.ReleaseNote(); ok {
+		if note == "" {
+			return e, false
+		}
+		e.Text = note
+	}
+	return e, true
+}
+
+// ChangelogManifest is the machine-readable form of the release notes of a version.
+type ChangelogManifest struct {
+	Version string           `json:"version"`
+	Entries []ChangelogEntry `json:"entries"`
+}
+
+// ReadChangelogManifest reads a changelog manifest in JSON format.
+func ReadChangelogManifest(r io.Reader) (ChangelogManifest, error) {
+	m := ChangelogManifest{}
+	err := json.NewDecoder(r).Decode(&m)
+	return m, err
+}
+
+// Inject returns a copy of the receiving manifest with the given hand-written entries (eg., the known issues) appended,
+// or an error when one of them is not valid, or has the ID of another entry.
+func (m ChangelogManifest) Inject(entries ...ChangelogEntry) (ChangelogManifest, error) {
+	ids := map[string]bool{}
+	for _, e := range m.Entries {
+		ids[e.ID] = true
+	}
+	for _, e := range entries {
+		if err := e.Validate(); err != nil {
+			return m, err
+		}
+		if ids[e.ID] {
+			return m, fmt.Errorf("duplicate changelog entry %q", e.ID)
+		}
+		ids[e.ID] = true
+	}
+
+	out := ChangelogManifest{Version: m.Version, Entries: append(m.Entries[:len(m.Entries):len(m.Entries)], entries...)}
+	return out, nil
+}
+
+// ChangelogOverride replaces or excludes the changelog entry of a commit, so that release managers can polish it
+// without rewriting the history.
+type ChangelogOverride struct {
+	// Text replaces the text of the entry, when not empty.
+	Text string `yaml:"text,omitempty"`
+	// Exclude removes the entry from the changelog.
+	Exclude bool `yaml:"exclude,omitempty"`
+}
+
+// ReadChangelogOverrides reads the overrides of the changelog entries, by their ID, in YAML format
+// (eg., a ".changelog-overrides.yaml" sidecar file).
+//
+//	a1b2c3d:
+//	  text: Support the v2 endpoints
+//	e4f5a6b:
+//	  exclude: true
+func ReadChangelogOverrides(r io.Reader) (map[string]ChangelogOverride, error) {
+	o := map[string]ChangelogOverride{}
+	if err := yaml.NewDecoder(r).Decode(&o); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Override returns a copy of the receiving manifest with the given overrides applied to its entries, matched by ID,
+// or an error when some of them do not match any entry (eg., because of a typo in the ID).
+func (m ChangelogManifest) Override(overrides map[string]ChangelogOverride) (ChangelogManifest, error) {
+	ids := map[string]bool{}
+	for _, e := range m.Entries {
+		ids[e.ID] = true
+	}
+	unknown := []string{}
+	for id := range overrides {
+		if !ids[id] {
+			unknown = append(unknown, strconv.Quote(id))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return m, fmt.Errorf("changelog overrides of unknown entries %s", strings.Join(unknown, ", "))
+	}
+
+	out := ChangelogManifest{Version: m.Version, Entries: []ChangelogEntry{}}
+	for _, e := range m.Entries {
+		o := overrides[e.ID]
+		if o.Exclude {
+			continue
+		}
+		if o.Text != "" {
+			e.Text = o.Text
+		}
+		out.Entries = append(out.Entries, e)
+	}
+
+	return out, nil
+}
+
+// ChangelogChange is an entry changed between two changelog manifests.
+type ChangelogChange struct {
+	Before ChangelogEntry `json:"before"`
+	After  ChangelogEntry `json:"after"`
+}
+
+// ChangelogDiff tells how the entries of a changelog manifest changed since a previous one.
+type ChangelogDiff struct {
+	Added   []ChangelogEntry  `json:"added"`
+	Removed []ChangelogEntry  `json:"removed"`
+	Changed []ChangelogChange `json:"changed"`
+}
+
+// Empty tells whether the entries did not change.
+func (d ChangelogDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffChangelogs computes the entries added, removed, and changed from the previous manifest to the current one,
+// matching them by ID (eg., to check what changed in the release notes since the last release candidate).
+//
+// The added and the changed entries follow the order of the current manifest, the removed ones the order of the previous one.
+func DiffChangelogs(previous, current ChangelogManifest) ChangelogDiff {
+	d := ChangelogDiff{Added: []ChangelogEntry{}, Removed: []ChangelogEntry{}, Changed: []ChangelogChange{}}
+	before := map[string]ChangelogEntry{}
+	for _, e := range previous.Entries {
+		before[e.ID] = e
+	}
+	after := map[string]bool{}
+	for _, e := range current.Entries {
+		after[e.ID] = true
+		b, ok := before[e.ID]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, e)
+		case b != e:
+			d.Changed = append(d.Changed, ChangelogChange{Before: b, After: e})
+		}
+	}
+	for _, e := range previous.Entries {
+		if !after[e.ID] {
+			d.Removed = append(d.Removed, e)
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,71 @@
+package conventionalcommits
+
+import "strings"
+
+// Predicate tells whether a commit message matches some condition.
+type Predicate func(c *ConventionalCommit) bool
+
+// TypeIs returns a predicate matching the commit messages having any of the given types.
+func TypeIs(types ...string) Predicate {
+	return func(c *ConventionalCommit) bool {
+		for _, t := range types {
+			if strings.EqualFold(c.Type, t) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ScopeIs returns a predicate matching the commit messages having any of the given scopes.
+func ScopeIs(scopes ...string) Predicate {
+	return func(c *ConventionalCommit) bool {
+		for _, s := range c.Scopes() {
+			for _, want := range scopes {
+				if strings.EqualFold(s, want) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// DefaultPublicAPIPredicates are the predicates AffectsPublicAPI uses when none is given.
+//
+// They match breaking changes, features, and fixes.
+var DefaultPublicAPIPredicates = []Predicate{
+	(*ConventionalCommit).IsBreakingChange,
+	TypeIs("feat", "fix"),
+}
+
+// IsFeature tells whether the receiving commit message introduces a feature.
+func (c *ConventionalCommit) IsFeature() bool {
+	return TypeIs("feat")(c)
+}
+
+// IsFix tells whether the receiving commit message patches a bug.
+func (c *ConventionalCommit) IsFix() bool {
+	return TypeIs("fix")(c)
+}
+
+// IsDocsOnly tells whether the receiving commit message only changes the documentation.
+func (c *ConventionalCommit) IsDocsOnly() bool {
+	return TypeIs("docs")(c) && !c.IsBreakingChange()
+}
+
+// AffectsPublicAPI tells whether the receiving commit message affects the public API.
+//
+// It does when any of the given predicates matches it.
+// Without predicates, it uses DefaultPublicAPIPredicates.
+func (c *ConventionalCommit) AffectsPublicAPI(predicates ...Predicate) bool {
+	if len(predicates) == 0 {
+		predicates = DefaultPublicAPIPredicates
+	}
+	for _, p := range predicates {
+		if p(c) {
+			return true
+		}
+	}
+	return false
+}
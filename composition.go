@@ -0,0 +1,90 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeCount tells how many commit messages have a given type.
+type TypeCount struct {
+	Type  string
+	Count int
+}
+
+// Composition summarizes what a set of commit messages, like the ones of a pull request, contains.
+type Composition struct {
+	// Total is the number of commit messages.
+	Total int
+	// Types counts the commit messages by type, from the most common.
+	Types []TypeCount
+	// Breaking is the number of breaking changes.
+	Breaking int
+	// Other is the number of messages that are not conventional commits.
+	Other int
+}
+
+// NewComposition summarizes the given commit messages.
+func NewComposition(messages []Message) Composition {
+	c := Composition{Total: len(messages), Types: []TypeCount{}}
+	counts := map[string]int{}
+	for _, m := range messages {
+		cc, ok := m.(*ConventionalCommit)
+		if !ok || cc == nil {
+			c.Other++
+			continue
+		}
+		counts[strings.ToLower(cc.Type)]++
+		if cc.IsBreakingChange() {
+			c.Breaking++
+		}
+	}
+	for t, n := range counts {
+		c.Types = append(c.Types, TypeCount{Type: t, Count: n})
+	}
+	sort.Slice(c.Types, func(a, b int) bool {
+		if c.Types[a].Count != c.Types[b].Count {
+			return c.Types[a].Count > c.Types[b].Count
+		}
+		return c.Types[a].Type < c.Types[b].Type
+	})
+
+	return c
+}
+
+// String renders the composition on a single line (eg., "3 feat, 2 fix, 1 breaking").
+func (c Composition) String() string {
+	if c.Total == 0 {
+		return "no commits"
+	}
+	parts := []string{}
+	for _, t := range c.Types {
+		parts = append(parts, fmt.Sprintf("%d %s", t.Count, t.Type))
+	}
+	if c.Breaking > 0 {
+		parts = append(parts, fmt.Sprintf("%d breaking", c.Breaking))
+	}
+	if c.Other > 0 {
+		parts = append(parts, fmt.Sprintf("%d other", c.Other))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Markdown renders the composition as a Markdown table, like for the summary of a check.
+func (c Composition) Markdown() string {
+	var b strings.Builder
+	b.WriteString("| Type | Commits |\n| --- | ---: |\n")
+	for _, t := range c.Types {
+		fmt.Fprintf(&b, "| %s | %d |\n", t.Type, t.Count)
+	}
+	if c.Other > 0 {
+		fmt.Fprintf(&b, "| _other_ | %d |\n", c.Other)
+	}
+	if c.Breaking > 0 {
+		fmt.Fprintf(&b, "| **breaking** | %d |\n", c.Breaking)
+	}
+	fmt.Fprintf(&b, "| **total** | %d |\n", c.Total)
+
+	return b.String()
+}
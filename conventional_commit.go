@@ -1,6 +1,9 @@
 package conventionalcommits
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -22,6 +25,18 @@ type TypeConfigurer interface {
 	WithTypes(t TypeConfig)
 }
 
+// TypeMatcher represents parsers able to match the types against a registry or a pattern, rather than a built-in set.
+type TypeMatcher interface {
+	WithTypeRegistry(r *TypeRegistry)
+	WithTypePattern(re *regexp.Regexp)
+	WithCaseInsensitiveTypes()
+}
+
+// ResultParser represents parsers able to return the positions of the parts of the messages and their warnings too.
+type ResultParser interface {
+	ParseResult(input []byte) Result
+}
+
 // BestEfforter is an interface that wraps the methods about the best effort mode.
 type BestEfforter interface {
 	WithBestEffort()
@@ -33,7 +48,162 @@ type Logger interface {
 	WithLogger(l *logrus.Logger)
 }
 
+// Postprocessor enriches or mutates the messages the parser returns (eg., extracting references, or attaching classifications).
+//
+// An error fails the parsing.
+type Postprocessor func(c *ConventionalCommit) error
+
+// PostprocessorSetter represents parsers able to run postprocessors on the messages they return.
+type PostprocessorSetter interface {
+	WithPostprocessor(p Postprocessor)
+}
+
+// ErrorFormatterSetter represents parsers able to render their errors with a custom formatter.
+type ErrorFormatterSetter interface {
+	WithErrorFormatter(f ErrorFormatter)
+}
+
+// HeaderLimiter represents parsers able to reject inputs with a too long header before parsing them.
+type HeaderLimiter interface {
+	WithHeaderLimit(n int)
+}
+
+// RewindLimiter represents parsers able to bound the backtracking they do on adversarial inputs.
+type RewindLimiter interface {
+	WithRewindBudget(n int)
+}
+
+// RuleEnforcer represents parsers able to enforce rules stricter than the specification.
+type RuleEnforcer interface {
+	WithRequiredScope()
+	WithNoScopeWhitespace()
+	WithStrictSpec()
+	WithTrivialDescriptionRule(r TrivialDescriptionRule)
+	WithMaxHeaderLength(n int)
+	WithNoTrailingPeriod()
+	WithDescriptionCase(c DescriptionCase)
+	WithStyleWarnings()
+	WithDuplicateFooterPolicy(p DuplicateFooterPolicy)
+	WithRequiredChangeID()
+	WithAllowedFooters(tokens []string)
+}
+
+// DescriptionCase represents the policies about the case of the first letter of the description.
+type DescriptionCase int
+
+const (
+	// CaseAny accepts any case.
+	CaseAny DescriptionCase = iota
+	// CaseLowerFirst requires a lowercase first letter, like in "fix: correct typo".
+	CaseLowerFirst
+	// CaseSentence requires an uppercase first letter, like in "fix: Correct typo".
+	CaseSentence
+)
+
+// String returns the name of the policy.
+func (c DescriptionCase) String() string {
+	switch c {
+	case CaseLowerFirst:
+		return "lower-first"
+	case CaseSentence:
+		return "sentence-case"
+	default:
+		return "any"
+	}
+}
+
+// DuplicateFooterPolicy represents the policies about the footer trailers repeating a token.
+type DuplicateFooterPolicy int
+
+const (
+	// DuplicateFootersAppend keeps all the values of the repeated tokens, in order.
+	DuplicateFootersAppend DuplicateFooterPolicy = iota
+	// DuplicateFootersError rejects the repeated tokens.
+	DuplicateFootersError
+	// DuplicateFootersFirstWins keeps only the first value of the repeated tokens.
+	DuplicateFootersFirstWins
+	// DuplicateFootersLastWins keeps only the last value of the repeated tokens.
+	DuplicateFootersLastWins
+)
+
+// String returns the name of the policy.
+func (p DuplicateFooterPolicy) String() string {
+	switch p {
+	case DuplicateFootersError:
+		return "error"
+	case DuplicateFootersFirstWins:
+		return "first-wins"
+	case DuplicateFootersLastWins:
+		return "last-wins"
+	default:
+		return "append"
+	}
+}
+
+// FooterKeyCase represents the forms of the footer trailer tokens in the ordered footers of the commit messages.
+//
+// The keys of the Footers map are always lowercase, so that looking them up is case-insensitive.
+type FooterKeyCase int
+
+const (
+	// FooterKeyLower lowercases the tokens, like the keys of the Footers map (eg., "signed-off-by").
+	FooterKeyLower FooterKeyCase = iota
+	// FooterKeyPreserve keeps the tokens as they are written (eg., "Signed-off-by" or "BREAKING-CHANGE").
+	FooterKeyPreserve
+	// FooterKeyTrainCase capitalizes every word of the tokens (eg., "Signed-Off-By"), but the "BREAKING CHANGE" ones.
+	FooterKeyTrainCase
+)
+
+// String returns the name of the form.
+func (c FooterKeyCase) String() string {
+	switch c {
+	case FooterKeyPreserve:
+		return "preserve"
+	case FooterKeyTrainCase:
+		return "train-case"
+	default:
+		return "lower"
+	}
+}
+
+// ScopeConfigurer represents parsers able to parse the scope in different ways.
+type ScopeConfigurer interface {
+	WithMultipleScopes()
+	WithScopePath()
+	WithScopeNormalization()
+}
+
+// FooterConfigurer represents parsers able to output the footer trailer tokens in different forms.
+type FooterConfigurer interface {
+	WithFooterKeyCase(c FooterKeyCase)
+}
+
+// DescriptionSeparator represents the white-space characters which can separate the colon from the description.
+type DescriptionSeparator int
+
+const (
+	// SeparatorSpaces accepts one or more spaces, as the specification says.
+	SeparatorSpaces DescriptionSeparator = iota
+	// SeparatorSpacesOrTab also accepts a single tab.
+	SeparatorSpacesOrTab
+	// SeparatorWhitespace accepts any run of spaces and tabs.
+	SeparatorWhitespace
+)
+
+// LenientConfigurer represents parsers able to accept common deviations from the specification.
+type LenientConfigurer interface {
+	WithLenientColonSpace()
+	WithLenientBlankLine()
+	WithTrimTrailingWhitespace()
+	WithSkipBOM()
+	WithDescriptionSeparator(s DescriptionSeparator)
+	WithMultilineFooters()
+}
+
 // Machine represent a FSM able to parse a conventional commit and return it in an structured way.
+//
+// The options type-assert the machines to the interfaces of the features they configure (eg., RuleEnforcer),
+// so that the machines implementing only some of them stay machines.
 type Machine interface {
 	Parse(input []byte) (Message, error)
 	BestEfforter
@@ -51,14 +221,47 @@ type Message interface {
 	HasFooter() bool
 }
 
+// Scoper represents messages with one or more scopes, like *ConventionalCommit.
+type Scoper interface {
+	Scopes() []string
+}
+
+// scopesOf returns the scopes of the given message, if it has any.
+func scopesOf(m Message) []string {
+	if s, ok := m.(Scoper); ok {
+		return s.Scopes()
+	}
+	return nil
+}
+
+// FooterReader represents messages able to look up their footer trailers, like *ConventionalCommit.
+type FooterReader interface {
+	Footer(token string) []string
+	Trailers() map[string][]string
+}
+
 // ConventionalCommit represents a commit message as per Conventional Commits specification.
 type ConventionalCommit struct {
-	Type        string
-	Description string
-	Scope       *string // optional
-	Exclamation bool
-	Body        *string             // optional
-	Footers     map[string][]string // optional
+	Type           string
+	Description    string
+	Scope          *string  // optional
+	MultipleScopes []string // optional, set when the parser splits the scope into multiple ones
+	ScopePath      []string // optional, set when the parser splits the scope into a path
+	Exclamation    bool
+	Body           *string             // optional
+	Footers        map[string][]string // optional
+	OrderedFooters []Footer            // optional, the footer trailers in the order they appear
+	Annotations    map[string]string   // optional, set by the postprocessors
+}
+
+// Footer represents a footer trailer of a commit message.
+type Footer struct {
+	// Token is the trailer token, as it is in the Footers map of the commit message,
+	// unless the parser outputs it in another form (see FooterKeyCase).
+	Token string
+	// Separator is the text between the token and the value, as written (eg., ": ", or " #" for "Refs #12"), if known.
+	Separator string
+	Value     string
 }
 
 // Ok tells whether the receiving commit message is well-formed or not.
@@ -69,12 +272,77 @@ func (c *ConventionalCommit) Ok() bool {
 }
 
 // IsBreakingChange tells whether the receiving commit message struct represents a breaking change or not.
+//
+// It checks both the "!" after the type and the "BREAKING CHANGE" (or "BREAKING-CHANGE") trailer.
 func (c *ConventionalCommit) IsBreakingChange() bool {
 	_, hasBreakingChangeTrailer := c.Footers["breaking-change"]
 	return c.Exclamation || hasBreakingChangeTrailer
 }
 
+// BreakingChangeDescription returns the text explaining the breaking change of the receiving commit message.
+//
+// It is the value of the "BREAKING CHANGE" trailer, if any (the values of all of them, when many, separated by a blank line),
+// otherwise the description when the type is followed by "!".
+// It returns false when the commit message is not a breaking change.
+func (c *ConventionalCommit) BreakingChangeDescription() (string, bool) {
+	if values := c.Footers["breaking-change"]; len(values) > 0 {
+		return strings.Join(values, "\n\n"), true
+	}
+	if c.Exclamation {
+		return c.Description, true
+	}
+	return "", false
+}
+
+// Scopes returns the scopes of the receiving commit message.
+//
+// When the parser splits the scope into multiple ones, it returns all of them.
+// Otherwise, it returns the scope, if any.
+func (c *ConventionalCommit) Scopes() []string {
+	if len(c.MultipleScopes) > 0 {
+		return c.MultipleScopes
+	}
+	if c.Scope != nil {
+		return []string{*c.Scope}
+	}
+	return nil
+}
+
 // HasFooter tells whether the receiving commit message struct has one or more trailers.
 func (c *ConventionalCommit) HasFooter() bool {
 	return len(c.Footers) > 0
 }
+
+// Footer returns the values of the trailers of the receiving commit message with the given token.
+//
+// The token is case-insensitive (eg., "Reviewed-by" and "reviewed-by" are the same), and
+// "BREAKING CHANGE" is the same as "BREAKING-CHANGE".
+func (c *ConventionalCommit) Footer(token string) []string {
+	return c.Footers[FooterKey(token)]
+}
+
+// Trailers returns a copy of the trailers of the receiving commit message, keyed by their lowercase token.
+func (c *ConventionalCommit) Trailers() map[string][]string {
+	trailers := make(map[string][]string, len(c.Footers))
+	for token, values := range c.Footers {
+		trailers[token] = append([]string{}, values...)
+	}
+	return trailers
+}
+
+// Annotate sets the annotation with the given key of the receiving commit message.
+func (c *ConventionalCommit) Annotate(key, value string) {
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[key] = value
+}
+
+// FooterKey returns the key of the trailers with the given token in the Footers map.
+func FooterKey(token string) string {
+	token = strings.ToLower(token)
+	if token == "breaking change" {
+		return "breaking-change"
+	}
+	return token
+}
@@ -0,0 +1,83 @@
+package conventionalcommits
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrMissingSignOff tells that a commit message has no "Signed-off-by" trailer.
+var ErrMissingSignOff = errors.New("missing 'Signed-off-by' footer trailer")
+
+// identityRegexp matches the identities as git writes them (eg., "Leo Di Donato <leo@example.com>").
+var identityRegexp = regexp.MustCompile(`^\s*([^<>]*?)\s*<([^<>\s]+@[^<>\s]+)>\s*$`)
+
+// Identity is the name and the email of a person, like the author of a commit or the one signing it off.
+type Identity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ParseIdentity parses an identity in the "Name <email>" format of git.
+func ParseIdentity(s string) (Identity, error) {
+	m := identityRegexp.FindStringSubmatch(s)
+	if m == nil || m[1] == "" {
+		return Identity{}, fmt.Errorf("invalid identity %q: expecting 'Name <email>'", s)
+	}
+	return Identity{Name: m[1], Email: m[2]}, nil
+}
+
+// String returns the identity in the "Name <email>" format of git.
+func (i Identity) String() string {
+	return i.Name + " <" + i.Email + ">"
+}
+
+// Matches tells whether the receiving identity is the given one.
+//
+// The emails are case-insensitive, and an empty name matches any name.
+func (i Identity) Matches(other Identity) bool {
+	if !strings.EqualFold(i.Email, other.Email) {
+		return false
+	}
+	return i.Name == "" || other.Name == "" || i.Name == other.Name
+}
+
+// SignOffs returns the identities of the "Signed-off-by" trailers of the receiving commit message, in order,
+// skipping the malformed ones.
+func (c *ConventionalCommit) SignOffs() []Identity {
+	out := []Identity{}
+	for _, v := range c.Footers["signed-off-by"] {
+		if i, err := ParseIdentity(v); err == nil {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// VerifyDCO checks whether the receiving commit message satisfies the Developer Certificate of Origin (DCO),
+// that is, it has at least one "Signed-off-by" trailer and all of them are well-formed identities.
+//
+// Unless the given author is the zero identity, one of the sign-offs must also match it (see Matches),
+// as the DCO requires the author to sign off the commit.
+func (c *ConventionalCommit) VerifyDCO(author Identity) error {
+	values := c.Footers["signed-off-by"]
+	if len(values) == 0 {
+		return ErrMissingSignOff
+	}
+	for _, v := range values {
+		if _, err := ParseIdentity(v); err != nil {
+			return fmt.Errorf("malformed 'Signed-off-by' footer trailer: %w", err)
+		}
+	}
+	if author == (Identity{}) {
+		return nil
+	}
+	for _, i := range c.SignOffs() {
+		if i.Matches(author) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("missing 'Signed-off-by' footer trailer of the author %s", author)
+}
@@ -0,0 +1,33 @@
+package conventionalcommits
+
+import "strings"
+
+// TrivialDescriptionRule tells which descriptions carry too little information, like "fix stuff" or "wip".
+type TrivialDescriptionRule struct {
+	// Denylist contains the trivial descriptions, matched regardless of their case and of a trailing period.
+	Denylist []string
+	// MinLength is the minimum length in bytes of a description, zero meaning no minimum.
+	MinLength int
+	// MinWords is the minimum number of words of a description, zero meaning no minimum.
+	MinWords int
+	// Error promotes the trivial descriptions from warnings to errors.
+	Error bool
+}
+
+// DefaultTrivialDescriptionRule flags the most common trivial descriptions and the single-word ones.
+var DefaultTrivialDescriptionRule = TrivialDescriptionRule{
+	Denylist: []string{"changes", "cleanup", "fix", "fix stuff", "fixes", "misc", "minor", "stuff", "tmp", "update", "updates", "wip"},
+	MinWords: 2,
+}
+
+// IsTrivial tells whether the given description is trivial.
+func (r *TrivialDescriptionRule) IsTrivial(description string) bool {
+	d := strings.TrimSuffix(strings.TrimSpace(description), ".")
+	for _, t := range r.Denylist {
+		if strings.EqualFold(d, t) {
+			return true
+		}
+	}
+
+	return len(d) < r.MinLength || len(strings.Fields(d)) < r.MinWords
+}
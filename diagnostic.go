@@ -0,0 +1,78 @@
+package conventionalcommits
+
+// Severity represents how serious a diagnostic is.
+type Severity int
+
+const (
+	// SeverityError is the severity of the issues that make a commit message invalid.
+	SeverityError Severity = iota
+	// SeverityWarning is the severity of the issues that do not make a commit message invalid.
+	SeverityWarning
+	// SeverityInfo is the severity of informative diagnostics.
+	SeverityInfo
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "error"
+	}
+}
+
+// Diagnostic represents an issue found in a commit message.
+//
+// It is the shape shared by the parser errors, the warnings, and any other check on commit messages,
+// so that reporters deal with a single type.
+type Diagnostic struct {
+	// Code identifies the kind of issue (eg., "type", or "missing-blank-line").
+	Code string
+	// Severity tells how serious the issue is.
+	Severity Severity
+	// Range is the part of the input the issue is about.
+	Range Span
+	// Position is the line and column where the issue occurs, when known.
+	Position Position
+	// Message describes the issue.
+	Message string
+	// Suggestion tells how to fix the issue, if known.
+	Suggestion string
+}
+
+// Diagnoser represents errors able to convert themselves into a diagnostic.
+type Diagnoser interface {
+	Diagnostic() Diagnostic
+}
+
+// NewDiagnostic converts an error into a diagnostic with the given severity.
+//
+// Errors not implementing Diagnoser become diagnostics with code "unknown" and no range.
+// Errors implementing Positioner tell the position of the diagnostic.
+func NewDiagnostic(err error, severity Severity) Diagnostic {
+	d := Diagnostic{Code: "unknown", Message: err.Error()}
+	if diagnoser, ok := err.(Diagnoser); ok {
+		d = diagnoser.Diagnostic()
+	}
+	if positioner, ok := err.(Positioner); ok {
+		d.Position = positioner.Position()
+	}
+	d.Severity = severity
+
+	return d
+}
+
+// ErrorInfo describes an error of the parser, so that custom formatters can render it.
+type ErrorInfo struct {
+	Diagnostic
+	// Template is the message template of the error (eg., "illegal '%s' character in scope").
+	Template string
+	// Args are the arguments of the template.
+	Args []interface{}
+}
+
+// ErrorFormatter renders the errors of the parser, for example as JSON or in another language.
+type ErrorFormatter func(info ErrorInfo) string
@@ -0,0 +1,94 @@
+package conventionalcommits
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Suggestion represents a type or a scope with its usage score.
+type Suggestion struct {
+	Value string
+	Score float64
+}
+
+// dictionaryEntry is a decayed usage count, up to date as of the given commit.
+type dictionaryEntry struct {
+	score float64
+	as    int
+}
+
+// Dictionary ranks the types and the scopes a history of commit messages uses, favoring the recent ones.
+//
+// It powers completions and suggestions with real usage data.
+// It is not safe for concurrent use.
+type Dictionary struct {
+	decay  float64
+	n      int
+	types  map[string]*dictionaryEntry
+	scopes map[string]*dictionaryEntry
+}
+
+// NewDictionary creates an empty dictionary.
+//
+// At every commit message, the scores of the previous ones get multiplied by the decay, between 0 and 1.
+// A decay of 1 means no decay at all.
+func NewDictionary(decay float64) *Dictionary {
+	return &Dictionary{
+		decay:  decay,
+		types:  map[string]*dictionaryEntry{},
+		scopes: map[string]*dictionaryEntry{},
+	}
+}
+
+// Add adds a commit message, more recent than the ones already added, to the receiving dictionary.
+func (d *Dictionary) Add(m Message) {
+	d.n++
+	if c, ok := m.(*ConventionalCommit); ok && c.Type != "" {
+		d.use(d.types, c.Type)
+	}
+	for _, s := range scopesOf(m) {
+		d.use(d.scopes, s)
+	}
+}
+
+// Types returns the types starting with the given prefix, from the highest score to the lowest.
+func (d *Dictionary) Types(prefix string) []Suggestion {
+	return d.suggest(d.types, prefix)
+}
+
+// Scopes returns the scopes starting with the given prefix, from the highest score to the lowest.
+func (d *Dictionary) Scopes(prefix string) []Suggestion {
+	return d.suggest(d.scopes, prefix)
+}
+
+func (d *Dictionary) use(entries map[string]*dictionaryEntry, value string) {
+	e, ok := entries[value]
+	if !ok {
+		e = &dictionaryEntry{}
+		entries[value] = e
+	}
+	e.score = d.decayed(e) + 1
+	e.as = d.n
+}
+
+func (d *Dictionary) decayed(e *dictionaryEntry) float64 {
+	return e.score * math.Pow(d.decay, float64(d.n-e.as))
+}
+
+func (d *Dictionary) suggest(entries map[string]*dictionaryEntry, prefix string) []Suggestion {
+	suggestions := []Suggestion{}
+	for value, e := range entries {
+		if strings.HasPrefix(value, prefix) {
+			suggestions = append(suggestions, Suggestion{Value: value, Score: d.decayed(e)})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Value < suggestions[j].Value
+	})
+
+	return suggestions
+}
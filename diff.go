@@ -0,0 +1,72 @@
+package conventionalcommits
+
+import (
+	"sort"
+	"strconv"
+)
+
+// MessageChange is a field differing between two commit messages.
+type MessageChange struct {
+	// Field is either "type", "scope", "exclamation", "description", "body", or "footer".
+	Field string `json:"field"`
+	// Token is the key of the footer trailers in the Footers map, for the footer changes.
+	Token string `json:"token,omitempty"`
+	// Index is the position of the value among the ones of the footer trailers with the token, for the footer changes.
+	Index int `json:"index,omitempty"`
+	// Before is the value of the field in the first commit message, nil when it has none.
+	Before *string `json:"before"`
+	// After is the value of the field in the second commit message, nil when it has none.
+	After *string `json:"after"`
+}
+
+// Diff returns the fields differing from the commit message a to the commit message b,
+// in the order they appear in the commit messages, and the footers sorted by token.
+//
+// It compares the values of the footer trailers with the same token one by one, in order,
+// so that it reports every added, removed, or changed footer trailer.
+// It ignores the multiple scopes and the scope path, since they derive from the scope, and the annotations.
+func Diff(a, b *ConventionalCommit) []MessageChange {
+	changes := []MessageChange{}
+	add := func(c MessageChange) {
+		if (c.Before == nil) != (c.After == nil) || (c.Before != nil && *c.Before != *c.After) {
+			changes = append(changes, c)
+		}
+	}
+	add(MessageChange{Field: "type", Before: &a.Type, After: &b.Type})
+	add(MessageChange{Field: "scope", Before: a.Scope, After: b.Scope})
+	exclamationBefore, exclamationAfter := strconv.FormatBool(a.Exclamation), strconv.FormatBool(b.Exclamation)
+	add(MessageChange{Field: "exclamation", Before: &exclamationBefore, After: &exclamationAfter})
+	add(MessageChange{Field: "description", Before: &a.Description, After: &b.Description})
+	add(MessageChange{Field: "body", Before: a.Body, After: b.Body})
+
+	tokens := []string{}
+	for token := range a.Footers {
+		tokens = append(tokens, token)
+	}
+	for token := range b.Footers {
+		if _, ok := a.Footers[token]; !ok {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Strings(tokens)
+	for _, token := range tokens {
+		before, after := a.Footers[token], b.Footers[token]
+		for i := 0; i < len(before) || i < len(after); i++ {
+			c := MessageChange{Field: "footer", Token: token, Index: i}
+			if i < len(before) {
+				c.Before = &before[i]
+			}
+			if i < len(after) {
+				c.After = &after[i]
+			}
+			add(c)
+		}
+	}
+
+	return changes
+}
+
+// Equal tells whether the given commit messages have the same fields, as Diff compares them.
+func Equal(a, b *ConventionalCommit) bool {
+	return len(Diff(a, b)) == 0
+}
@@ -0,0 +1,49 @@
+package conventionalcommits
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
+
+// Fingerprint returns a stable hash of the semantic content of the receiving commit message,
+// for deduplication, cache keys, or to detect semantically identical cherry-picks.
+//
+// It covers the type and the scopes, both lowercase, whether it is a breaking change, the description, and the footer trailers,
+// ignoring the whitespace differences and the order of the trailers.
+// It ignores the body, where "git cherry-pick -x" records the original commit.
+func (c *ConventionalCommit) Fingerprint() string {
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	write(strings.ToLower(c.Type))
+	for _, s := range c.Scopes() {
+		write(strings.ToLower(strings.TrimSpace(s)))
+	}
+	if c.IsBreakingChange() {
+		write("!")
+	}
+	write(collapseWhitespace(c.Description))
+
+	trailers := []string{}
+	for token, values := range c.Footers {
+		for _, v := range values {
+			trailers = append(trailers, token+":"+collapseWhitespace(v))
+		}
+	}
+	sort.Strings(trailers)
+	for _, t := range trailers {
+		write(t)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// collapseWhitespace trims the given text and replaces its runs of whitespace with single spaces.
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
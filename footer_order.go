@@ -0,0 +1,58 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FooterOrderOthers is the entry of a footer order standing for the tokens it does not list.
+const FooterOrderOthers = "*"
+
+// DefaultFooterOrder puts the breaking changes first, then the references, and the sign-offs last.
+var DefaultFooterOrder = []string{"breaking-change", "refs", FooterOrderOthers, "signed-off-by"}
+
+// SortFooters returns a copy of the input where the footer trailers follow the given order of tokens.
+//
+// The tokens are the ones of the Footers map of the commit message (eg., "breaking-change"),
+// and FooterOrderOthers stands for the unlisted ones, which otherwise come last.
+// Trailers with the same rank keep their relative order, and everything but their order stays untouched.
+func SortFooters(input []byte, sourceMap SourceMap, order []string) ([]byte, error) {
+	footers := sourceMap.Footers
+	if len(footers) < 2 {
+		return append([]byte{}, input...), nil
+	}
+	for i := 1; i < len(footers); i++ {
+		if footers[i].Span.Start < footers[i-1].Span.End {
+			return nil, fmt.Errorf("overlapping footer trailers at %d", footers[i].Span.Start)
+		}
+	}
+
+	ranks := map[string]int{}
+	others := len(order)
+	for i, token := range order {
+		if token == FooterOrderOthers {
+			others = i
+		} else {
+			ranks[token] = i
+		}
+	}
+	rank := func(f FooterSpan) int {
+		if r, ok := ranks[f.Token]; ok {
+			return r
+		}
+		return others
+	}
+
+	sorted := append([]FooterSpan{}, footers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(sorted[i]) < rank(sorted[j])
+	})
+
+	// Each trailer slot receives the text of the trailer sorted into it
+	edits := make([]Edit, len(footers))
+	for i, f := range footers {
+		edits[i] = Edit{Span: f.Span, Text: string(sorted[i].Span.Text(input))}
+	}
+
+	return Splice(input, edits...)
+}
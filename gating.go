@@ -0,0 +1,75 @@
+package conventionalcommits
+
+// SetPredicate tells whether a set of commit messages, like the ones of a pull request, matches some condition.
+type SetPredicate func(messages []Message) bool
+
+// HasFooter returns a predicate matching the commit messages having a trailer with any of the given tokens.
+//
+// The tokens are case-insensitive (eg., "Security" matches "security: CVE-2021-1234").
+func HasFooter(tokens ...string) Predicate {
+	return func(c *ConventionalCommit) bool {
+		for _, token := range tokens {
+			if _, ok := c.Footers[FooterKey(token)]; ok {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AnyCommit returns a predicate matching the sets of commit messages in which at least one commit message matches p.
+//
+// It ignores the messages that are not conventional commits.
+func AnyCommit(p Predicate) SetPredicate {
+	return func(messages []Message) bool {
+		for _, m := range messages {
+			if c, ok := m.(*ConventionalCommit); ok && c != nil && p(c) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AllCommits returns a predicate matching the non-empty sets of commit messages in which every commit message matches p.
+//
+// The messages that are not conventional commits never match.
+func AllCommits(p Predicate) SetPredicate {
+	return func(messages []Message) bool {
+		for _, m := range messages {
+			if c, ok := m.(*ConventionalCommit); !ok || c == nil || !p(c) {
+				return false
+			}
+		}
+		return len(messages) > 0
+	}
+}
+
+// AnyBreaking tells whether any of the given commit messages is a breaking change.
+func AnyBreaking(messages []Message) bool {
+	return AnyCommit((*ConventionalCommit).IsBreakingChange)(messages)
+}
+
+// AllSignedOff tells whether all the given commit messages have a "Signed-off-by" trailer.
+func AllSignedOff(messages []Message) bool {
+	return AllCommits(HasFooter("Signed-off-by"))(messages)
+}
+
+// Gates names the predicates workflows gate the merges of pull requests on.
+type Gates map[string]SetPredicate
+
+// DefaultGates are the gates most repositories use.
+var DefaultGates = Gates{
+	"breaking":   AnyBreaking,
+	"signed-off": AllSignedOff,
+	"security":   AnyCommit(HasFooter("Security")),
+}
+
+// Evaluate returns the outcome of every gate on the given commit messages, keyed by the names of the gates.
+func (g Gates) Evaluate(messages []Message) map[string]bool {
+	report := make(map[string]bool, len(g))
+	for name, p := range g {
+		report[name] = p(messages)
+	}
+	return report
+}
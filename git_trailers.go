@@ -0,0 +1,60 @@
+package conventionalcommits
+
+import (
+	"strings"
+)
+
+// GitTrailerFormat tells how GitTrailers renders the footer trailers.
+type GitTrailerFormat struct {
+	// Separator separates the tokens from the values, ": " when empty, like git does by default.
+	Separator string
+	// Separators overrides the separator of some tokens (eg., " #" for "Refs"), keyed by token, case-insensitive.
+	//
+	// The trailers written with the " #" separator (eg., "Refs #133") keep it, unless overridden,
+	// and otherwise the '#' goes back into their value (eg., "Refs: #133"), so that the issue references survive.
+	//
+	// Git only recognizes the separators starting with one of the characters of its trailer.separators setting.
+	Separators map[string]string
+}
+
+// GitTrailers renders the footer trailers of the receiving commit message, in their order,
+// in the format "git interpret-trailers" outputs and parses back, one trailer per line.
+//
+// It capitalizes the tokens (eg., "Reviewed-by") and, since git tokens can't contain spaces,
+// it writes the breaking changes with the "BREAKING-CHANGE" token, which the specification allows too.
+func (c *ConventionalCommit) GitTrailers(f GitTrailerFormat) string {
+	separators := make(map[string]string, len(f.Separators))
+	for token, sep := range f.Separators {
+		separators[FooterKey(token)] = sep
+	}
+	footers := c.OrderedFooters
+	if len(footers) == 0 {
+		footers = sortedFooters(c.Footers)
+	}
+
+	var b strings.Builder
+	for _, t := range footers {
+		token := footerToken(t.Token)
+		if FooterKey(t.Token) == "breaking-change" {
+			token = "BREAKING-CHANGE"
+		}
+		sep, ok := separators[FooterKey(t.Token)]
+		switch {
+		case ok:
+		case t.Separator == " #":
+			sep = t.Separator
+		default:
+			sep = f.Separator
+		}
+		if sep == "" {
+			sep = ": "
+		}
+		value := t.Value
+		if t.Separator == " #" && !strings.HasSuffix(sep, "#") {
+			value = "#" + value
+		}
+		b.WriteString(token + sep + value + "\n")
+	}
+
+	return b.String()
+}
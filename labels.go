@@ -0,0 +1,55 @@
+package conventionalcommits
+
+import "sort"
+
+// LabelRule maps the commit messages matching a predicate to a set of labels.
+type LabelRule struct {
+	Match  Predicate
+	Labels []string
+}
+
+// LabelMapping maps commit messages to labels through a list of rules.
+//
+// All the rules matching a commit message contribute to its labels.
+type LabelMapping []LabelRule
+
+// DefaultLabelMapping is a mapping for the labels most repositories use.
+var DefaultLabelMapping = LabelMapping{
+	{Match: TypeIs("feat"), Labels: []string{"enhancement"}},
+	{Match: TypeIs("fix"), Labels: []string{"bug"}},
+	{Match: TypeIs("docs"), Labels: []string{"documentation"}},
+	{Match: (*ConventionalCommit).IsBreakingChange, Labels: []string{"semver:major"}},
+}
+
+// Labels returns the sorted labels of the given commit message.
+func (l LabelMapping) Labels(c *ConventionalCommit) []string {
+	return l.Suggest([]Message{c})
+}
+
+// Suggest returns the sorted labels of a set of commit messages, like the ones of a pull request.
+//
+// It ignores the messages that are not conventional commits.
+func (l LabelMapping) Suggest(messages []Message) []string {
+	seen := map[string]bool{}
+	labels := []string{}
+	for _, m := range messages {
+		c, ok := m.(*ConventionalCommit)
+		if !ok || c == nil {
+			continue
+		}
+		for _, r := range l {
+			if !r.Match(c) {
+				continue
+			}
+			for _, label := range r.Labels {
+				if !seen[label] {
+					seen[label] = true
+					labels = append(labels, label)
+				}
+			}
+		}
+	}
+	sort.Strings(labels)
+
+	return labels
+}
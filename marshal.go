@@ -0,0 +1,79 @@
+package conventionalcommits
+
+import (
+	"sort"
+	"strings"
+)
+
+// Marshal renders the receiving commit message as text, from its structured fields,
+// so that bots can parse a commit message, modify it, and write it back.
+//
+// It writes the footer trailers in their order, with their separator, ": " when unknown, and their tokens capitalized
+// (eg., "Reviewed-by", or "BREAKING CHANGE").
+// Differently from splicing edits into the input, it does not preserve the original formatting.
+func (c *ConventionalCommit) Marshal() []byte {
+	var b strings.Builder
+	b.WriteString(c.Type)
+	if c.Scope != nil {
+		b.WriteString("(" + *c.Scope + ")")
+	}
+	if c.Exclamation {
+		b.WriteString("!")
+	}
+	b.WriteString(": " + c.Description)
+	if c.Body != nil && *c.Body != "" {
+		b.WriteString("\n\n" + *c.Body)
+	}
+
+	footers := c.OrderedFooters
+	if len(footers) == 0 {
+		footers = sortedFooters(c.Footers)
+	}
+	for i, f := range footers {
+		if i == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + footerLine(f))
+	}
+
+	return []byte(b.String())
+}
+
+// footerLine renders the given footer trailer, with its separator, ": " when unknown.
+func footerLine(f Footer) string {
+	sep := f.Separator
+	if sep == "" {
+		sep = ": "
+	}
+	return footerToken(f.Token) + sep + f.Value
+}
+
+// sortedFooters returns the footer trailers of the given map, sorted by token.
+func sortedFooters(footers map[string][]string) []Footer {
+	tokens := make([]string, 0, len(footers))
+	for token := range footers {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	out := []Footer{}
+	for _, token := range tokens {
+		for _, v := range footers[token] {
+			out = append(out, Footer{Token: token, Value: v})
+		}
+	}
+	return out
+}
+
+// footerToken returns the conventional spelling of the given footer key.
+//
+// The tokens the parser outputs in another form than the lowercase one (see FooterKeyCase) stay as they are.
+func footerToken(key string) string {
+	if key == "" || key != strings.ToLower(key) {
+		return key
+	}
+	if key == "breaking-change" {
+		return "BREAKING CHANGE"
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
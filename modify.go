@@ -0,0 +1,111 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// footerTokenRegexp matches the footer trailer tokens the parser accepts.
+var footerTokenRegexp = regexp.MustCompile(`^(?:[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*|BREAKING[ -]CHANGE)$`)
+
+// WithType returns a copy of the receiving commit message with the given type.
+//
+// It returns an error when the type is empty, or contains whitespace or the characters delimiting the header parts.
+// Since the receiving commit message does not know the types the parser accepted, check them by parsing the marshaled copy.
+func (c *ConventionalCommit) WithType(t string) (*ConventionalCommit, error) {
+	if t == "" || strings.ContainsAny(t, " \t\r\n():!") {
+		return nil, fmt.Errorf("invalid type %q", t)
+	}
+	out := c.clone()
+	out.Type = t
+	return out, nil
+}
+
+// WithScope returns a copy of the receiving commit message with the given scope, or without scope when empty.
+//
+// It returns an error when the scope is blank, spans many lines, or contains parentheses.
+// The copy has no multiple scopes nor scope path, since they depend on the options of the parser:
+// parse the marshaled copy to split its scope again.
+func (c *ConventionalCommit) WithScope(s string) (*ConventionalCommit, error) {
+	if s != "" && (strings.TrimSpace(s) == "" || strings.ContainsAny(s, "\r\n()")) {
+		return nil, fmt.Errorf("invalid scope %q", s)
+	}
+	out := c.clone()
+	out.Scope = nil
+	if s != "" {
+		out.Scope = &s
+	}
+	out.MultipleScopes, out.ScopePath = nil, nil
+	return out, nil
+}
+
+// WithDescription returns a copy of the receiving commit message with the given description.
+//
+// It returns an error when the description is blank or spans many lines.
+func (c *ConventionalCommit) WithDescription(d string) (*ConventionalCommit, error) {
+	if strings.TrimSpace(d) == "" || strings.ContainsAny(d, "\r\n") {
+		return nil, fmt.Errorf("invalid description %q", d)
+	}
+	out := c.clone()
+	out.Description = d
+	return out, nil
+}
+
+// WithBody returns a copy of the receiving commit message with the given body, or without body when blank.
+func (c *ConventionalCommit) WithBody(b string) *ConventionalCommit {
+	out := c.clone()
+	out.Body = nil
+	if strings.TrimSpace(b) != "" {
+		out.Body = &b
+	}
+	return out
+}
+
+// AddFooter returns a copy of the receiving commit message with a footer trailer with the given token and value appended.
+//
+// The token is case-insensitive, like in the Footer method.
+// It returns an error when the parser would not accept the token, or when the value is blank or spans many lines.
+func (c *ConventionalCommit) AddFooter(token, value string) (*ConventionalCommit, error) {
+	if !footerTokenRegexp.MatchString(token) {
+		return nil, fmt.Errorf("invalid footer token %q", token)
+	}
+	if strings.TrimSpace(value) == "" || strings.ContainsAny(value, "\r\n") {
+		return nil, fmt.Errorf("invalid '%s' footer value %q", token, value)
+	}
+	out := c.clone()
+	if len(out.OrderedFooters) == 0 && len(out.Footers) > 0 {
+		out.OrderedFooters = sortedFooters(out.Footers)
+	}
+	if out.Footers == nil {
+		out.Footers = map[string][]string{}
+	}
+	key := FooterKey(token)
+	out.Footers[key] = append(out.Footers[key], value)
+	out.OrderedFooters = append(out.OrderedFooters, Footer{Token: key, Value: value})
+	return out, nil
+}
+
+// clone returns a deep copy of the receiving commit message.
+func (c *ConventionalCommit) clone() *ConventionalCommit {
+	out := *c
+	if c.Scope != nil {
+		scope := *c.Scope
+		out.Scope = &scope
+	}
+	if c.Body != nil {
+		body := *c.Body
+		out.Body = &body
+	}
+	out.MultipleScopes = append([]string(nil), c.MultipleScopes...)
+	out.ScopePath = append([]string(nil), c.ScopePath...)
+	if c.Footers != nil {
+		out.Footers = c.Trailers()
+	}
+	out.OrderedFooters = append([]Footer(nil), c.OrderedFooters...)
+	out.Annotations = nil
+	for k, v := range c.Annotations {
+		out.Annotate(k, v)
+	}
+	return &out
+}
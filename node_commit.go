@@ -0,0 +1,141 @@
+package conventionalcommits
+
+import (
+	"regexp"
+	"strings"
+)
+
+// NodeNote is a note of a NodeCommit, like the description of a breaking change.
+type NodeNote struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// NodeReference is a reference to an issue of a NodeCommit (eg., "Closes owner/repo#12").
+type NodeReference struct {
+	Action     *string `json:"action"`
+	Owner      *string `json:"owner"`
+	Repository *string `json:"repository"`
+	Issue      string  `json:"issue"`
+	Raw        string  `json:"raw"`
+	Prefix     string  `json:"prefix"`
+}
+
+// NodeRevert tells which commit a NodeCommit reverts.
+type NodeRevert struct {
+	Header string `json:"header"`
+	Hash   string `json:"hash"`
+}
+
+// NodeCommit is a commit message with the field names and the shape of the output of the Node conventional-commits-parser,
+// so that the JavaScript tools downstream keep working unchanged when encoding it to JSON.
+//
+// See https://github.com/conventional-changelog/conventional-changelog/tree/master/packages/conventional-commits-parser
+type NodeCommit struct {
+	Type       *string         `json:"type"`
+	Scope      *string         `json:"scope"`
+	Subject    *string         `json:"subject"`
+	Merge      *string         `json:"merge"`
+	Header     *string         `json:"header"`
+	Body       *string         `json:"body"`
+	Footer     *string         `json:"footer"`
+	Notes      []NodeNote      `json:"notes"`
+	References []NodeReference `json:"references"`
+	Mentions   []string        `json:"mentions"`
+	Revert     *NodeRevert     `json:"revert"`
+}
+
+// nodeReferenceActions are the keywords of the Node parser that close the issues they precede.
+var nodeReferenceActions = map[string]bool{
+	"close": true, "closes": true, "closed": true,
+	"fix": true, "fixes": true, "fixed": true,
+	"resolve": true, "resolves": true, "resolved": true,
+}
+
+var (
+	nodeReferenceRegexp = regexp.MustCompile(`(?:([A-Za-z]+)\s+)?(?:([\w.-]+)/([\w.-]+))?#(\d+)`)
+	nodeMentionRegexp   = regexp.MustCompile(`@([\w-]+)`)
+	nodeRevertRegexp    = regexp.MustCompile(`This reverts commit (\w+)\.`)
+)
+
+// NewNodeCommit converts the given commit message into the shape of the Node conventional-commits-parser.
+//
+// Like that parser, it reports the breaking changes as "BREAKING CHANGE" notes,
+// the issues referenced anywhere in the message, and the mentions of users (eg., "@leodido").
+// The footer keeps the separators of the trailers, so that "Closes #12" references the issue it closes.
+// The merge field is always null, since a merge commit is not a conventional commit.
+func NewNodeCommit(c *ConventionalCommit) NodeCommit {
+	header := string(c.Marshal())
+	if i := strings.IndexByte(header, '\n'); i >= 0 {
+		header = header[:i]
+	}
+	n := NodeCommit{
+		Type:       &c.Type,
+		Scope:      c.Scope,
+		Subject:    &c.Description,
+		Header:     &header,
+		Body:       c.Body,
+		Notes:      []NodeNote{},
+		References: []NodeReference{},
+		Mentions:   []string{},
+	}
+
+	footers := c.OrderedFooters
+	if len(footers) == 0 {
+		footers = sortedFooters(c.Footers)
+	}
+	lines := []string{}
+	for _, f := range footers {
+		lines = append(lines, footerLine(f))
+		if FooterKey(f.Token) == "breaking-change" {
+			n.Notes = append(n.Notes, NodeNote{Title: "BREAKING CHANGE", Text: f.Value})
+		}
+	}
+	if len(lines) > 0 {
+		footer := strings.Join(lines, "\n")
+		n.Footer = &footer
+	}
+	if c.Exclamation && len(n.Notes) == 0 {
+		n.Notes = append(n.Notes, NodeNote{Title: "BREAKING CHANGE", Text: c.Description})
+	}
+
+	text := []string{c.Description}
+	if c.Body != nil {
+		text = append(text, *c.Body)
+	}
+	if n.Footer != nil {
+		text = append(text, *n.Footer)
+	}
+	for _, t := range text {
+		n.References = append(n.References, nodeReferences(t)...)
+		for _, m := range nodeMentionRegexp.FindAllStringSubmatch(t, -1) {
+			n.Mentions = append(n.Mentions, m[1])
+		}
+	}
+
+	if strings.EqualFold(c.Type, "revert") && c.Body != nil {
+		if m := nodeRevertRegexp.FindStringSubmatch(*c.Body); m != nil {
+			n.Revert = &NodeRevert{Header: c.Description, Hash: m[1]}
+		}
+	}
+
+	return n
+}
+
+// nodeReferences returns the references to issues in the given text.
+func nodeReferences(text string) []NodeReference {
+	refs := []NodeReference{}
+	for _, m := range nodeReferenceRegexp.FindAllStringSubmatch(text, -1) {
+		r := NodeReference{Issue: m[4], Prefix: "#", Raw: strings.TrimSpace(strings.TrimPrefix(m[0], m[1]))}
+		if nodeReferenceActions[strings.ToLower(m[1])] {
+			action := m[1]
+			r.Action = &action
+		}
+		if m[3] != "" {
+			owner, repository := m[2], m[3]
+			r.Owner, r.Repository = &owner, &repository
+		}
+		refs = append(refs, r)
+	}
+	return refs
+}
@@ -0,0 +1,70 @@
+package conventionalcommits
+
+import (
+	"strings"
+)
+
+// Normalize returns a copy of the receiving commit message in canonical form,
+// for auto-fix tools and for the stable hashing of commit messages.
+//
+// The canonical form has a lowercase type, and the whitespace around the scopes, the description, the body,
+// and the footer trailer values trimmed.
+// Once rendered with Marshal, it has a single space after the colon, exactly one blank line between the header, the body,
+// and the footer trailers, and the "BREAKING CHANGE" token for the breaking changes, even when written "BREAKING-CHANGE".
+// The footer trailers keep the " #" separator (eg., "Refs #133"), and have the ": " one otherwise.
+func (c *ConventionalCommit) Normalize() *ConventionalCommit {
+	out := &ConventionalCommit{
+		Type:        strings.ToLower(c.Type),
+		Description: strings.TrimSpace(c.Description),
+		Exclamation: c.Exclamation,
+	}
+	if c.Scope != nil {
+		scope := strings.TrimSpace(*c.Scope)
+		out.Scope = &scope
+	}
+	out.MultipleScopes = trimAll(c.MultipleScopes)
+	out.ScopePath = trimAll(c.ScopePath)
+	if c.Body != nil {
+		if body := strings.TrimSpace(*c.Body); body != "" {
+			out.Body = &body
+		}
+	}
+	if c.Footers != nil {
+		out.Footers = make(map[string][]string, len(c.Footers))
+		for token, values := range c.Footers {
+			out.Footers[token] = trimAll(values)
+		}
+	}
+	for _, f := range c.OrderedFooters {
+		out.OrderedFooters = append(out.OrderedFooters, Footer{Token: FooterKey(f.Token), Separator: canonicalSeparator(f.Separator), Value: strings.TrimSpace(f.Value)})
+	}
+	for k, v := range c.Annotations {
+		out.Annotate(k, v)
+	}
+
+	return out
+}
+
+// canonicalSeparator returns the canonical form of the given footer trailer separator, if known.
+func canonicalSeparator(sep string) string {
+	switch {
+	case sep == "":
+		return ""
+	case strings.HasSuffix(sep, "#"):
+		return " #"
+	default:
+		return ": "
+	}
+}
+
+// trimAll returns a copy of the given strings, trimmed.
+func trimAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.TrimSpace(v)
+	}
+	return out
+}
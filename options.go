@@ -1,6 +1,8 @@
 package conventionalcommits
 
 import (
+	"regexp"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,6 +22,235 @@ func WithTypes(t TypeConfig) MachineOption {
 	}
 }
 
+// WithTypeRegistry ...
+func WithTypeRegistry(r *TypeRegistry) MachineOption {
+	return func(m Machine) Machine {
+		m.(TypeMatcher).WithTypeRegistry(r)
+		return m
+	}
+}
+
+// WithCustomTypes ...
+func WithCustomTypes(types []string) MachineOption {
+	return WithTypeRegistry(NewTypeRegistryOf(types...))
+}
+
+// WithTypePattern ...
+func WithTypePattern(re *regexp.Regexp) MachineOption {
+	return func(m Machine) Machine {
+		m.(TypeMatcher).WithTypePattern(re)
+		return m
+	}
+}
+
+// WithCaseInsensitiveTypes ...
+func WithCaseInsensitiveTypes() MachineOption {
+	return func(m Machine) Machine {
+		m.(TypeMatcher).WithCaseInsensitiveTypes()
+		return m
+	}
+}
+
+// WithHeaderLimit ...
+func WithHeaderLimit(n int) MachineOption {
+	return func(m Machine) Machine {
+		m.(HeaderLimiter).WithHeaderLimit(n)
+		return m
+	}
+}
+
+// WithRewindBudget ...
+func WithRewindBudget(n int) MachineOption {
+	return func(m Machine) Machine {
+		m.(RewindLimiter).WithRewindBudget(n)
+		return m
+	}
+}
+
+// WithRequiredScope ...
+func WithRequiredScope() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithRequiredScope()
+		return m
+	}
+}
+
+// WithNoScopeWhitespace ...
+func WithNoScopeWhitespace() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithNoScopeWhitespace()
+		return m
+	}
+}
+
+// WithStrictSpec ...
+func WithStrictSpec() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithStrictSpec()
+		return m
+	}
+}
+
+// WithTrivialDescriptionRule ...
+func WithTrivialDescriptionRule(r TrivialDescriptionRule) MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithTrivialDescriptionRule(r)
+		return m
+	}
+}
+
+// WithMaxHeaderLength ...
+func WithMaxHeaderLength(n int) MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithMaxHeaderLength(n)
+		return m
+	}
+}
+
+// WithNoTrailingPeriod ...
+func WithNoTrailingPeriod() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithNoTrailingPeriod()
+		return m
+	}
+}
+
+// WithDescriptionCase ...
+func WithDescriptionCase(c DescriptionCase) MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithDescriptionCase(c)
+		return m
+	}
+}
+
+// WithDuplicateFooterPolicy ...
+func WithDuplicateFooterPolicy(p DuplicateFooterPolicy) MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithDuplicateFooterPolicy(p)
+		return m
+	}
+}
+
+// WithRequiredChangeID ...
+func WithRequiredChangeID() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithRequiredChangeID()
+		return m
+	}
+}
+
+// WithAllowedFooters ...
+func WithAllowedFooters(tokens []string) MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithAllowedFooters(tokens)
+		return m
+	}
+}
+
+// WithStyleWarnings ...
+func WithStyleWarnings() MachineOption {
+	return func(m Machine) Machine {
+		m.(RuleEnforcer).WithStyleWarnings()
+		return m
+	}
+}
+
+// WithMultipleScopes ...
+func WithMultipleScopes() MachineOption {
+	return func(m Machine) Machine {
+		m.(ScopeConfigurer).WithMultipleScopes()
+		return m
+	}
+}
+
+// WithScopePath ...
+func WithScopePath() MachineOption {
+	return func(m Machine) Machine {
+		m.(ScopeConfigurer).WithScopePath()
+		return m
+	}
+}
+
+// WithScopeNormalization ...
+func WithScopeNormalization() MachineOption {
+	return func(m Machine) Machine {
+		m.(ScopeConfigurer).WithScopeNormalization()
+		return m
+	}
+}
+
+// WithFooterKeyCase ...
+func WithFooterKeyCase(c FooterKeyCase) MachineOption {
+	return func(m Machine) Machine {
+		m.(FooterConfigurer).WithFooterKeyCase(c)
+		return m
+	}
+}
+
+// WithLenientColonSpace ...
+func WithLenientColonSpace() MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithLenientColonSpace()
+		return m
+	}
+}
+
+// WithLenientBlankLine ...
+func WithLenientBlankLine() MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithLenientBlankLine()
+		return m
+	}
+}
+
+// WithTrimTrailingWhitespace ...
+func WithTrimTrailingWhitespace() MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithTrimTrailingWhitespace()
+		return m
+	}
+}
+
+// WithSkipBOM ...
+func WithSkipBOM() MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithSkipBOM()
+		return m
+	}
+}
+
+// WithDescriptionSeparator ...
+func WithDescriptionSeparator(s DescriptionSeparator) MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithDescriptionSeparator(s)
+		return m
+	}
+}
+
+// WithMultilineFooters ...
+func WithMultilineFooters() MachineOption {
+	return func(m Machine) Machine {
+		m.(LenientConfigurer).WithMultilineFooters()
+		return m
+	}
+}
+
+// WithErrorFormatter ...
+func WithErrorFormatter(f ErrorFormatter) MachineOption {
+	return func(m Machine) Machine {
+		m.(ErrorFormatterSetter).WithErrorFormatter(f)
+		return m
+	}
+}
+
+// WithPostprocessor ...
+func WithPostprocessor(p Postprocessor) MachineOption {
+	return func(m Machine) Machine {
+		m.(PostprocessorSetter).WithPostprocessor(p)
+		return m
+	}
+}
+
 // WithLogger ...
 func WithLogger(l *logrus.Logger) MachineOption {
 	return func(m Machine) Machine {
@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// ErrFooterNotAllowed tells the user that the token of a footer trailer is not among the allowed ones.
+const ErrFooterNotAllowed = "expecting one of the allowed footer tokens, got '%s' token"
+
+// checkAllowedFooter checks the token of the current footer trailer against the allowed ones, if any.
+//
+// It remembers the first violation, so that checkRules can report it after the machine ran.
+// In best effort mode, the violations are warnings rather than errors.
+func (m *machine) checkAllowedFooter() {
+	if m.allowedFooters == nil || m.isAllowedFooter(m.currentFooterKey) {
+		return
+	}
+	span := conventionalcommits.Span{Start: m.footerTokenStart, End: m.footerSepStart}
+	err := m.emitErrorAt(span, ErrFooterNotAllowed, string(span.Text(m.data)), m.footerTokenStart)
+	if m.bestEffort {
+		m.warnings = append(m.warnings, err)
+		return
+	}
+	if m.disallowedFooter == nil {
+		m.disallowedFooter = err
+	}
+}
+
+// isAllowedFooter tells whether the footer trailers with the given key are among the allowed ones.
+func (m *machine) isAllowedFooter(key string) bool {
+	for _, token := range m.allowedFooters {
+		if conventionalcommits.FooterKey(token) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseSummary(t *testing.T) {
+	m := conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "a1", Type: "feat", Scope: "users", Text: "add <id> lookups"},
+			{ID: "a2", Type: "fix", Text: "crash"},
+			{ID: "a3", Type: "feat", Breaking: true, Text: "drop v1"},
+			{ID: "known-issue-1", Section: "Known issues", Text: "The v1 clients time out"},
+		},
+	}
+	s := m.Summary(conventionalcommits.ReleaseLink{Title: "Changelog", URL: "https://example.com/v2.0.0"})
+	assert.Equal(t, conventionalcommits.ReleaseSummary{
+		Version:    "v2.0.0",
+		Highlights: []conventionalcommits.ChangelogEntry{m.Entries[0], m.Entries[3]},
+		Breaking:   []conventionalcommits.ChangelogEntry{m.Entries[2]},
+		Links:      []conventionalcommits.ReleaseLink{{Title: "Changelog", URL: "https://example.com/v2.0.0"}},
+	}, s)
+
+	slack, err := s.SlackMessage()
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"text": "Release v2.0.0",
+		"blocks": [
+			{"type": "header", "text": {"type": "plain_text", "text": "Release v2.0.0"}},
+			{"type": "section", "text": {"type": "mrkdwn", "text": "*Highlights*\n• *users:* add &lt;id&gt; lookups\n• The v1 clients time out"}},
+			{"type": "section", "text": {"type": "mrkdwn", "text": "*Breaking changes*\n• drop v1"}},
+			{"type": "context", "elements": [{"type": "mrkdwn", "text": "<https://example.com/v2.0.0|Changelog>"}]}
+		]
+	}`, string(slack))
+
+	teams, err := s.TeamsMessage()
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "message",
+		"attachments": [{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content": {
+				"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+				"type": "AdaptiveCard",
+				"version": "1.4",
+				"body": [
+					{"type": "TextBlock", "text": "Release v2.0.0", "size": "Large", "weight": "Bolder", "wrap": true},
+					{"type": "TextBlock", "text": "Highlights", "weight": "Bolder", "spacing": "Medium", "wrap": true},
+					{"type": "TextBlock", "text": "- **users:** add <id> lookups\n- The v1 clients time out", "wrap": true},
+					{"type": "TextBlock", "text": "Breaking changes", "weight": "Bolder", "spacing": "Medium", "wrap": true},
+					{"type": "TextBlock", "text": "- drop v1", "wrap": true}
+				],
+				"actions": [{"type": "Action.OpenUrl", "title": "Changelog", "url": "https://example.com/v2.0.0"}]
+			}
+		}]
+	}`, string(teams))
+
+	// Without highlights, breaking changes, nor links, only the version remains
+	slack, err = conventionalcommits.ChangelogManifest{Version: "v2.0.1"}.Summary().SlackMessage()
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"text": "Release v2.0.1", "blocks": [{"type": "header", "text": {"type": "plain_text", "text": "Release v2.0.1"}}]}`, string(slack))
+}
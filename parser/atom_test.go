@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseFeed(t *testing.T) {
+	feed := conventionalcommits.ReleaseFeed{ID: "https://example.com/releases", Title: "example releases", Link: "https://example.com/releases", Author: "example"}
+	v1 := conventionalcommits.FeedRelease{
+		Manifest: conventionalcommits.ChangelogManifest{Version: "v1.0.0", Entries: []conventionalcommits.ChangelogEntry{{ID: "a1", Type: "feat", Text: "add endpoint"}}},
+		Date:     time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC),
+	}
+	v2 := conventionalcommits.FeedRelease{
+		Manifest: conventionalcommits.ChangelogManifest{Version: "v2.0.0", Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "b1", Type: "feat", Scope: "users", Breaking: true, Text: "drop <v1>"},
+			{ID: "b2", Type: "fix", Text: "crash"},
+		}},
+		Date: time.Date(2024, 5, 2, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+		Link: "https://example.com/releases/v2.0.0",
+	}
+
+	out, err := feed.Atom(v1, v2)
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://example.com/releases</id>
+  <title>example releases</title>
+  <updated>2024-05-02T10:00:00Z</updated>
+  <link href="https://example.com/releases"></link>
+  <author>
+    <name>example</name>
+  </author>
+  <entry>
+    <id>https://example.com/releases/v2.0.0</id>
+    <title>v2.0.0</title>
+    <updated>2024-05-02T10:00:00Z</updated>
+    <link href="https://example.com/releases/v2.0.0"></link>
+    <content type="html">&lt;h3&gt;feat&lt;/h3&gt;&lt;ul&gt;&lt;li&gt;&lt;strong&gt;users:&lt;/strong&gt; drop &amp;lt;v1&amp;gt; (breaking)&lt;/li&gt;&lt;/ul&gt;&lt;h3&gt;fix&lt;/h3&gt;&lt;ul&gt;&lt;li&gt;crash&lt;/li&gt;&lt;/ul&gt;</content>
+  </entry>
+  <entry>
+    <id>https://example.com/releases#v1.0.0</id>
+    <title>v1.0.0</title>
+    <updated>2024-04-01T10:00:00Z</updated>
+    <content type="html">&lt;h3&gt;feat&lt;/h3&gt;&lt;ul&gt;&lt;li&gt;add endpoint&lt;/li&gt;&lt;/ul&gt;</content>
+  </entry>
+</feed>
+`, string(out))
+
+	out, err = conventionalcommits.ReleaseFeed{ID: "urn:example", Title: "empty"}.Atom()
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>urn:example</id>
+  <title>empty</title>
+  <updated>1970-01-01T00:00:00Z</updated>
+</feed>
+`, string(out))
+}
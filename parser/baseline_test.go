@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseline(t *testing.T) {
+	typ := conventionalcommits.Diagnostic{Code: "type"}
+	blank := conventionalcommits.Diagnostic{Code: "missing-blank-line"}
+
+	b := conventionalcommits.NewBaseline()
+	b.Add("a1", typ, blank)
+	b.Add("b2", typ)
+	assert.Equal(t, 3, b.Len())
+
+	assert.Equal(t, []conventionalcommits.Diagnostic{}, b.Filter("a1", []conventionalcommits.Diagnostic{typ, blank}))
+	assert.Equal(t, []conventionalcommits.Diagnostic{blank}, b.Filter("b2", []conventionalcommits.Diagnostic{typ, blank}))
+	assert.Equal(t, []conventionalcommits.Diagnostic{typ}, b.Filter("c3", []conventionalcommits.Diagnostic{typ}))
+
+	var out bytes.Buffer
+	_, err := b.WriteTo(&out)
+	assert.Nil(t, err)
+	assert.Equal(t, "a1 missing-blank-line\na1 type\nb2 type\n", out.String())
+
+	read, err := conventionalcommits.ReadBaseline(strings.NewReader("# legacy\n\n" + out.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, b, read)
+
+	assert.Equal(t, 2, read.Shrink(map[string][]conventionalcommits.Diagnostic{"a1": {blank}, "b2": {}}))
+	assert.Equal(t, 1, read.Len())
+	assert.True(t, read.Contains("a1", blank))
+
+	_, err = conventionalcommits.ReadBaseline(strings.NewReader("a1\n"))
+	assert.EqualError(t, err, "malformed baseline entry at line 1")
+}
@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchPolicies(t *testing.T) {
+	policies := conventionalcommits.BranchPolicies{
+		{Pattern: "release/*", Types: []string{"fix", "docs"}},
+		{Pattern: "main", Types: []string{"feat", "fix", "docs", "chore"}},
+	}
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+	feat, _ := p.Parse([]byte("feat: add endpoint"))
+	fix, _ := p.Parse([]byte("fix: crash"))
+
+	assert.Nil(t, policies.Check("release/1.2", fix.(*conventionalcommits.ConventionalCommit)))
+	assert.EqualError(t, policies.Check("refs/heads/release/1.2", feat.(*conventionalcommits.ConventionalCommit)), "type 'feat' not allowed into branch 'release/1.2': expecting one of fix, docs")
+	assert.Nil(t, policies.Check("main", feat.(*conventionalcommits.ConventionalCommit)))
+	assert.Nil(t, policies.Check("feature/x", feat.(*conventionalcommits.ConventionalCommit)))
+
+	invalid := conventionalcommits.BranchPolicies{{Pattern: "release/[", Types: []string{"fix"}}}
+	assert.Error(t, invalid.Check("release/1", fix.(*conventionalcommits.ConventionalCommit)))
+}
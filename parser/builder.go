@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Builder builds a commit message field by field, like the bots creating commits do.
+//
+// It is the inverse of parsing: it validates the commit message with the same rules the parser enforces,
+// reporting the typed error (eg., ScopeError or TrailerError) about the faulty part.
+// Its methods return the receiving builder so that the calls chain,
+// and the first error stops the building.
+type Builder struct {
+	draft   Draft
+	options []conventionalcommits.MachineOption
+	err     error
+}
+
+// NewBuilder creates a builder validating the commit message with a machine configured with the given options.
+func NewBuilder(options ...conventionalcommits.MachineOption) *Builder {
+	return &Builder{options: options}
+}
+
+// Type sets the type of the commit message.
+func (b *Builder) Type(t string) *Builder {
+	b.draft.Type = b.line("type", t)
+	return b
+}
+
+// Scope sets the scope of the commit message.
+func (b *Builder) Scope(s string) *Builder {
+	b.draft.Scope = b.line("scope", s)
+	return b
+}
+
+// Description sets the description of the commit message.
+func (b *Builder) Description(d string) *Builder {
+	b.draft.Description = b.line("description", d)
+	return b
+}
+
+// Body sets the body of the commit message.
+func (b *Builder) Body(body string) *Builder {
+	b.draft.Body = body
+	return b
+}
+
+// Footer appends a footer trailer with the given token and value (eg., "Refs" and "#12").
+func (b *Builder) Footer(token, value string) *Builder {
+	b.draft.Trailers = append(b.draft.Trailers, Trailer{Token: b.line("footer token", token), Value: b.line("footer value", value)})
+	return b
+}
+
+// Breaking marks the commit message as a breaking change, with the "!" after the type and the scope.
+//
+// A non-empty note describes the breaking change in a "BREAKING CHANGE" footer trailer.
+func (b *Builder) Breaking(note string) *Builder {
+	b.draft.Breaking = true
+	if note != "" {
+		b.Footer("BREAKING CHANGE", note)
+	}
+	return b
+}
+
+// Draft returns the parts of the commit message built so far.
+func (b *Builder) Draft() Draft {
+	return b.draft
+}
+
+// Build renders the commit message, ready for "git commit -F -", once valid.
+func (b *Builder) Build() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.draft.Build(b.options...)
+}
+
+// Message returns the structured commit message, once valid.
+func (b *Builder) Message() (*conventionalcommits.ConventionalCommit, error) {
+	out, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := NewMachine(b.options...).Parse(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.(*conventionalcommits.ConventionalCommit), nil
+}
+
+// line returns the given value of a single line field, recording the error when it spans many lines,
+// since it would change the structure of the rendered commit message (eg., a description turning into a body).
+func (b *Builder) line(field, value string) string {
+	if b.err == nil && strings.ContainsAny(value, "\r\n") {
+		b.err = fmt.Errorf("the %s %q spans many lines", field, value)
+	}
+	return value
+}
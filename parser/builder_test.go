@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(WithTypes(conventionalcommits.TypesConventional)).
+		Type("feat").
+		Scope("parser").
+		Description("drop the v1 options").
+		Body("They were deprecated a year ago.").
+		Breaking("the v1 options are gone").
+		Footer("Refs", "#12")
+	out, err := b.Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "feat(parser)!: drop the v1 options\n\nThey were deprecated a year ago.\n\nBREAKING CHANGE: the v1 options are gone\nRefs: #12", string(out))
+
+	msg, err := b.Message()
+	assert.Nil(t, err)
+	assert.Equal(t, "feat", msg.Type)
+	assert.Equal(t, "parser", *msg.Scope)
+	assert.True(t, msg.IsBreakingChange())
+	assert.Equal(t, []string{"#12"}, msg.Footers["refs"])
+	assert.Equal(t, out, msg.Marshal())
+
+	out, err = NewBuilder().Type("fix").Description("typo").Breaking("").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "fix!: typo", string(out))
+
+	_, err = NewBuilder().Type("feature").Description("x").Build()
+	assert.IsType(t, &HeaderError{}, err)
+	assert.EqualError(t, err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 4))
+
+	_, err = NewBuilder(WithRequiredScope()).Type("fix").Description("x").Message()
+	assert.IsType(t, &ScopeError{}, err)
+
+	_, err = NewBuilder().Type("fix").Description("x\n\nnot a body").Footer("Refs", "#1").Build()
+	assert.EqualError(t, err, `the description "x\n\nnot a body" spans many lines`)
+}
@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	c := conventionalcommits.Capabilities()
+	assert.Equal(t, []conventionalcommits.Capability{{Name: "conventionalcommits", Version: "1.0.0"}}, c.Specs)
+	assert.True(t, c.Has("minimal"))
+	assert.True(t, c.Has("free-form"))
+	assert.True(t, c.Has("best-effort"))
+	assert.True(t, c.Has("custom-types"))
+	assert.True(t, c.Has("case-insensitive-types"))
+	assert.True(t, c.Has("multiple-scopes"))
+	assert.True(t, c.Has("scope-path"))
+	assert.True(t, c.Has("scope-normalization"))
+	assert.True(t, c.Has("type-pattern"))
+	assert.True(t, c.Has("yaml"))
+	assert.True(t, c.Has("protobuf"))
+	assert.True(t, c.Has("node"))
+	assert.True(t, c.Has("git-trailers"))
+	assert.False(t, c.Has("unknown"))
+
+	// Callers can't alter the capabilities
+	c.Presets[0].Name = "changed"
+	assert.True(t, conventionalcommits.Capabilities().Has("minimal"))
+}
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrChangeID tells the user that the value of a Change-Id footer trailer is not a valid Gerrit Change-Id.
+	ErrChangeID = "expecting a Change-Id made of 'I' and 40 hexadecimal digits, got '%s' value"
+	// ErrChangeIDRequired tells the user that the Change-Id footer trailer is mandatory.
+	ErrChangeIDRequired = "expecting a 'Change-Id' footer trailer, got none"
+)
+
+// recordChangeID remembers where the value of the current footer trailer is, when it is a mandatory Change-Id,
+// so that checkRequiredChangeID can check it once the machine ran.
+func (m *machine) recordChangeID() {
+	if m.requiredChangeID && m.currentFooterKey == "change-id" {
+		m.changeIDs = append(m.changeIDs, conventionalcommits.Span{Start: m.pb, End: m.p})
+	}
+}
+
+// checkRequiredChangeID checks that the commit message has a valid Change-Id footer trailer, and no invalid ones.
+//
+// It checks the values the duplicate footer policy kept, without the trailing whitespace when the parser ignores it.
+func (m *machine) checkRequiredChangeID(output *conventionalCommit) error {
+	values := output.footers["change-id"]
+	if len(values) == 0 {
+		m.p = m.pe
+		return m.emitErrorWithoutCharacter(ErrChangeIDRequired)
+	}
+
+	// The values kept are in the order of the recorded ones
+	next := 0
+	for _, span := range m.changeIDs {
+		if next == len(values) {
+			break
+		}
+		if string(span.Text(m.data)) != values[next] {
+			continue
+		}
+		next++
+		value := values[next-1]
+		if m.trimTrailingWhitespace {
+			value = strings.TrimRight(value, lineTrailingWhitespace)
+		}
+		if !conventionalcommits.IsChangeID(value) {
+			span.End = span.Start + len(value)
+			return m.emitErrorAt(span, ErrChangeID, value, span.Start)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogEntry(t *testing.T) {
+	e, ok := conventionalcommits.NewChangelogEntry("a1", classify(t, "feat(api)!: drop v1"))
+	assert.True(t, ok)
+	assert.Equal(t, conventionalcommits.ChangelogEntry{ID: "a1", Type: "feat", Scope: "api", Breaking: true, Text: "drop v1"}, e)
+
+	e, ok = conventionalcommits.NewChangelogEntry("b2", classify(t, "fix: typo\n\nRelease-note: Fixed the help message"))
+	assert.True(t, ok)
+	assert.Equal(t, "Fixed the help message", e.Text)
+
+	_, ok = conventionalcommits.NewChangelogEntry("c3", classify(t, "chore: bump deps\n\nRelease-note: NONE"))
+	assert.False(t, ok)
+}
+
+func TestReadChangelogManifest(t *testing.T) {
+	m, err := conventionalcommits.ReadChangelogManifest(strings.NewReader(`{
+		"version": "v2.0.0",
+		"entries": [
+			{"id": "a1", "type": "feat", "scope": "api", "breaking": true, "text": "drop v1"},
+			{"id": "b2", "type": "fix", "text": "typo"}
+		]
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "a1", Type: "feat", Scope: "api", Breaking: true, Text: "drop v1"},
+			{ID: "b2", Type: "fix", Text: "typo"},
+		},
+	}, m)
+
+	_, err = conventionalcommits.ReadChangelogManifest(strings.NewReader(`{"entries": 1}`))
+	assert.Error(t, err)
+}
+
+func TestDiffChangelogs(t *testing.T) {
+	rc1, err := conventionalcommits.ReadChangelogManifest(strings.NewReader(`{
+		"version": "v2.0.0-rc.1",
+		"entries": [
+			{"id": "a1", "type": "feat", "text": "add endpoint"},
+			{"id": "b2", "type": "fix", "text": "typo"},
+			{"id": "c3", "type": "fix", "text": "crash"}
+		]
+	}`))
+	assert.Nil(t, err)
+	rc2 := conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0-rc.2",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "d4", Type: "feat", Breaking: true, Text: "drop v1"},
+			{ID: "a1", Type: "feat", Text: "add endpoint"},
+			{ID: "c3", Type: "fix", Text: "crash on empty payloads"},
+		},
+	}
+
+	d := conventionalcommits.DiffChangelogs(rc1, rc2)
+	assert.Equal(t, []conventionalcommits.ChangelogEntry{rc2.Entries[0]}, d.Added)
+	assert.Equal(t, []conventionalcommits.ChangelogEntry{rc1.Entries[1]}, d.Removed)
+	assert.Equal(t, []conventionalcommits.ChangelogChange{{Before: rc1.Entries[2], After: rc2.Entries[2]}}, d.Changed)
+	assert.False(t, d.Empty())
+
+	assert.True(t, conventionalcommits.DiffChangelogs(rc2, rc2).Empty())
+}
+
+func TestChangelogOverrides(t *testing.T) {
+	m := conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "a1", Type: "feat", Text: "add endpoint"},
+			{ID: "b2", Type: "fix", Text: "typo"},
+			{ID: "c3", Type: "fix", Text: "crash"},
+		},
+	}
+	o, err := conventionalcommits.ReadChangelogOverrides(strings.NewReader(`
+a1:
+  text: Add the /v2/users endpoint
+b2:
+  exclude: true
+`))
+	assert.Nil(t, err)
+
+	out, err := m.Override(o)
+	assert.Nil(t, err)
+	assert.Equal(t, conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "a1", Type: "feat", Text: "Add the /v2/users endpoint"},
+			{ID: "c3", Type: "fix", Text: "crash"},
+		},
+	}, out)
+	// The manifest stays the same
+	assert.Equal(t, "add endpoint", m.Entries[0].Text)
+
+	_, err = m.Override(map[string]conventionalcommits.ChangelogOverride{"a1": {Exclude: true}, "z9": {Exclude: true}, "b3": {Text: "typo"}})
+	assert.EqualError(t, err, `changelog overrides of unknown entries "b3", "z9"`)
+
+	o, err = conventionalcommits.ReadChangelogOverrides(strings.NewReader(""))
+	assert.Nil(t, err)
+	assert.Empty(t, o)
+
+	_, err = conventionalcommits.ReadChangelogOverrides(strings.NewReader("a1: [1, 2]"))
+	assert.Error(t, err)
+}
+
+func TestChangelogInject(t *testing.T) {
+	m := conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{{ID: "a1", Type: "feat", Text: "add endpoint"}},
+	}
+	issue := conventionalcommits.ChangelogEntry{ID: "known-issue-1", Section: "Known issues", Text: "The v1 clients time out"}
+
+	out, err := m.Inject(issue)
+	assert.Nil(t, err)
+	assert.Equal(t, []conventionalcommits.ChangelogEntry{m.Entries[0], issue}, out.Entries)
+	assert.Len(t, m.Entries, 1)
+
+	_, err = m.Inject(conventionalcommits.ChangelogEntry{ID: "a1", Section: "Known issues", Text: "again"})
+	assert.EqualError(t, err, `duplicate changelog entry "a1"`)
+	_, err = m.Inject(issue, issue)
+	assert.EqualError(t, err, `duplicate changelog entry "known-issue-1"`)
+	_, err = m.Inject(conventionalcommits.ChangelogEntry{ID: "n1", Text: "no section"})
+	assert.EqualError(t, err, `changelog entry "n1" without type nor section`)
+	_, err = m.Inject(conventionalcommits.ChangelogEntry{Section: "Docs", Text: "no ID"})
+	assert.EqualError(t, err, "changelog entry without ID")
+}
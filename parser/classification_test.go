@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func classify(t *testing.T, input string) *conventionalcommits.ConventionalCommit {
+	t.Helper()
+	res, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(input))
+	assert.Nil(t, err)
+	return res.(*conventionalcommits.ConventionalCommit)
+}
+
+func TestClassification(t *testing.T) {
+	feat := classify(t, "feat(api): add endpoint")
+	assert.True(t, feat.IsFeature())
+	assert.False(t, feat.IsFix())
+	assert.False(t, feat.IsDocsOnly())
+	assert.True(t, feat.AffectsPublicAPI())
+
+	fix := classify(t, "FIX: crash")
+	assert.True(t, fix.IsFix())
+	assert.True(t, fix.AffectsPublicAPI())
+
+	docs := classify(t, "docs(readme): typo")
+	assert.True(t, docs.IsDocsOnly())
+	assert.False(t, docs.AffectsPublicAPI())
+
+	breaking := classify(t, "docs!: drop the v1 guide")
+	assert.False(t, breaking.IsDocsOnly())
+	assert.True(t, breaking.AffectsPublicAPI())
+
+	refactor := classify(t, "refactor(api): rename internals")
+	assert.False(t, refactor.AffectsPublicAPI())
+	assert.True(t, refactor.AffectsPublicAPI(conventionalcommits.ScopeIs("api")))
+	assert.False(t, refactor.AffectsPublicAPI(conventionalcommits.TypeIs("perf"), conventionalcommits.ScopeIs("cli")))
+}
+
+func TestFooterAccessors(t *testing.T) {
+	m, err := NewMachine().Parse([]byte("fix: typo\n\nRefs: #1\nReviewed-by: Leo\nRefs: #2\nBREAKING CHANGE: renamed flag"))
+	assert.Nil(t, err)
+
+	// No need to know the concrete type of the message
+	res, ok := m.(conventionalcommits.FooterReader)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"#1", "#2"}, res.Footer("Refs"))
+	assert.Equal(t, []string{"Leo"}, res.Footer("reviewed-by"))
+	assert.Equal(t, []string{"renamed flag"}, res.Footer("BREAKING CHANGE"))
+	assert.Nil(t, res.Footer("Signed-off-by"))
+
+	trailers := res.Trailers()
+	assert.Equal(t, map[string][]string{"refs": {"#1", "#2"}, "reviewed-by": {"Leo"}, "breaking-change": {"renamed flag"}}, trailers)
+	trailers["refs"][0] = "#3"
+	assert.Equal(t, []string{"#1", "#2"}, res.Footer("Refs"))
+
+	m, _ = NewMachine().Parse([]byte("fix: typo"))
+	assert.Empty(t, m.(conventionalcommits.FooterReader).Trailers())
+}
+
+func TestBreakingChangeDescription(t *testing.T) {
+	d, ok := classify(t, "feat!: drop v1\n\nBREAKING CHANGE: the v1 endpoints are gone").BreakingChangeDescription()
+	assert.True(t, ok)
+	assert.Equal(t, "the v1 endpoints are gone", d)
+
+	d, ok = classify(t, "feat!: drop v1").BreakingChangeDescription()
+	assert.True(t, ok)
+	assert.Equal(t, "drop v1", d)
+
+	d, ok = classify(t, "feat: drop v1\n\nBREAKING-CHANGE: v1 is gone\nBREAKING CHANGE: v0 too").BreakingChangeDescription()
+	assert.True(t, ok)
+	assert.Equal(t, "v1 is gone\n\nv0 too", d)
+
+	_, ok = classify(t, "feat: add v2").BreakingChangeDescription()
+	assert.False(t, ok)
+}
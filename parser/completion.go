@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"sort"
+)
+
+// CompleteTypes returns the sorted types, and their aliases, the configuration accepts starting with the given prefix.
+//
+// It is meant for shell completions and editors.
+// It returns nil when the configuration accepts any type, like the free-form set or a type pattern do:
+// a dictionary of the types in the history can complete them instead.
+func (c ParserConfig) CompleteTypes(prefix string) []string {
+	if c.typePattern != nil {
+		return nil
+	}
+	r := c.registry()
+	if r == nil {
+		r = c.typeConfig.Registry()
+	}
+	if r == nil {
+		return nil
+	}
+
+	types := []string{}
+	for _, t := range r.Tokens() {
+		if len(t) >= len(prefix) && r.Equal(t[:len(prefix)], prefix) {
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+
+	return types
+}
@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteTypes(t *testing.T) {
+	c := NewParserConfig(WithTypes(conventionalcommits.TypesConventional))
+	assert.Equal(t, []string{"chore", "ci"}, c.CompleteTypes("c"))
+	assert.Equal(t, []string{"feat"}, c.CompleteTypes("FE"))
+	assert.Len(t, c.CompleteTypes(""), 11)
+	assert.Empty(t, c.CompleteTypes("x"))
+
+	assert.Equal(t, []string{"feat", "fix"}, NewParserConfig().CompleteTypes("f"))
+
+	r := conventionalcommits.NewTypeRegistry(conventionalcommits.TypeDefinition{Name: "feature", Aliases: []string{"feat"}})
+	assert.Equal(t, []string{"feat", "feature"}, NewParserConfig(WithTypeRegistry(r)).CompleteTypes("fe"))
+	assert.Empty(t, NewParserConfig(WithTypeRegistry(r)).CompleteTypes("FE"))
+
+	assert.Nil(t, NewParserConfig(WithTypes(conventionalcommits.TypesFreeForm)).CompleteTypes("f"))
+	assert.Nil(t, NewParserConfig(WithTypePattern(regexp.MustCompile("^[a-z]+$"))).CompleteTypes("f"))
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposition(t *testing.T) {
+	messages := []conventionalcommits.Message{
+		classify(t, "feat(api): add endpoint"),
+		classify(t, "fix: crash"),
+		classify(t, "feat!: drop v1"),
+		classify(t, "FEAT: add flag"),
+		classify(t, "fix: typo\n\nBREAKING CHANGE: renamed flag"),
+		classify(t, "docs: readme"),
+		nil,
+	}
+	c := conventionalcommits.NewComposition(messages)
+	assert.Equal(t, conventionalcommits.Composition{
+		Total:    7,
+		Types:    []conventionalcommits.TypeCount{{Type: "feat", Count: 3}, {Type: "fix", Count: 2}, {Type: "docs", Count: 1}},
+		Breaking: 2,
+		Other:    1,
+	}, c)
+	assert.Equal(t, "3 feat, 2 fix, 1 docs, 2 breaking, 1 other", c.String())
+	assert.Equal(t, `| Type | Commits |
+| --- | ---: |
+| feat | 3 |
+| fix | 2 |
+| docs | 1 |
+| _other_ | 1 |
+| **breaking** | 2 |
+| **total** | 7 |
+`, c.Markdown())
+
+	assert.Equal(t, "no commits", conventionalcommits.NewComposition(nil).String())
+}
@@ -1,42 +1,100 @@
 package parser
 
 import (
+	"bytes"
 	"strings"
 
 	"github.com/reviewpad/go-conventionalcommits"
 )
 
+// conventionalCommit accumulates the parts of a commit message while parsing it.
+//
+// The type and the body are byte slices since the machine can set or extend them
+// one character at a time; they become strings only on export.
 type conventionalCommit struct {
-	_type       string
-	descr       string
-	scope       string
-	exclamation bool
-	body        string
-	footers     map[string][]string
+	_type         []byte
+	canonicalType bool
+	descr         string
+	scope         string
+	scopes        []string
+	scopePath     []string
+	exclamation   bool
+	body          []byte
+	footers       map[string][]string
+	footerOrder   []string
+	footerTokens  []string
+	footerSeps    []string
 }
 
 func (c *conventionalCommit) minimal() bool {
-	return c._type != "" && c.descr != ""
+	return len(c._type) > 0 && c.descr != ""
+}
+
+// addFooter adds the value of a footer trailer, keeping track of the order of the trailers, of their tokens, and of their separators.
+func (c *conventionalCommit) addFooter(key, token, separator, value string) {
+	c.footers[key] = append(c.footers[key], value)
+	c.footerOrder = append(c.footerOrder, key)
+	c.footerTokens = append(c.footerTokens, token)
+	c.footerSeps = append(c.footerSeps, separator)
+}
+
+// dropFooter removes the value of the i-th footer trailer with the given key.
+func (c *conventionalCommit) dropFooter(key string, i int) {
+	values := c.footers[key]
+	c.footers[key] = append(values[:i:i], values[i+1:]...)
+	for j, k := range c.footerOrder {
+		if k != key {
+			continue
+		}
+		if i == 0 {
+			c.footerOrder = append(c.footerOrder[:j:j], c.footerOrder[j+1:]...)
+			c.footerTokens = append(c.footerTokens[:j:j], c.footerTokens[j+1:]...)
+			c.footerSeps = append(c.footerSeps[:j:j], c.footerSeps[j+1:]...)
+			return
+		}
+		i--
+	}
+}
+
+// canonicalizeType replaces the type with the canonical name it has in the given registry.
+func (c *conventionalCommit) canonicalizeType(r *conventionalcommits.TypeRegistry) {
+	if d, ok := r.Lookup(string(c._type)); ok {
+		c._type = []byte(d.Name)
+		c.canonicalType = true
+	}
 }
 
 func (c *conventionalCommit) export() conventionalcommits.Message {
 	out := &conventionalcommits.ConventionalCommit{}
 	out.Exclamation = c.exclamation
-	out.Type = strings.ToLower(c._type)
+	out.Type = string(c._type)
+	if !c.canonicalType {
+		out.Type = strings.ToLower(out.Type)
+	}
 	out.Description = c.descr
 	if c.scope != "" {
 		c.scope = strings.ToLower(c.scope)
 		out.Scope = &c.scope
 	}
-	if c.body != "" {
+	for _, s := range c.scopes {
+		out.MultipleScopes = append(out.MultipleScopes, strings.ToLower(s))
+	}
+	for _, s := range c.scopePath {
+		out.ScopePath = append(out.ScopePath, strings.ToLower(s))
+	}
+	if len(c.body) > 0 {
 		// Trim suffix blank line
-		if len(c.body) >= 2 && c.body[len(c.body)-2:] == "\n\n" {
-			c.body = c.body[:len(c.body)-2]
-		}
-		out.Body = &c.body
+		body := string(bytes.TrimSuffix(c.body, []byte("\n\n")))
+		out.Body = &body
 	}
 	if len(c.footers) > 0 {
 		out.Footers = c.footers
+		// The values of each token are in order too
+		next := map[string]int{}
+		for i, key := range c.footerOrder {
+			out.OrderedFooters = append(out.OrderedFooters, conventionalcommits.Footer{Token: c.footerTokens[i], Separator: c.footerSeps[i], Value: c.footers[key][next[key]]})
+			next[key]++
+		}
 	}
 
 	return out
@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Converter rewrites the header of a commit message written in another convention as a draft, if it can.
+//
+// Only the type, the scope, the breaking flag, the description, and the trailers of the draft matter.
+type Converter func(header string) (Draft, bool)
+
+// DefaultConverters are the converters Convert uses when none is given.
+var DefaultConverters = []Converter{ConvertAngular, ConvertBrackets, ConvertGitmoji, ConvertJira}
+
+// typeAliases maps the types of other conventions to the conventional ones.
+var typeAliases = map[string]string{
+	"feature":     "feat",
+	"features":    "feat",
+	"bug":         "fix",
+	"bugfix":      "fix",
+	"hotfix":      "fix",
+	"doc":         "docs",
+	"tests":       "test",
+	"testing":     "test",
+	"performance": "perf",
+	"refactoring": "refactor",
+	"chores":      "chore",
+}
+
+// gitmojis maps the most common gitmojis, as emojis or as codes, to the type they imply.
+var gitmojis = map[string]string{
+	"✨": "feat", ":sparkles:": "feat",
+	"🐛": "fix", ":bug:": "fix",
+	"🚑": "fix", ":ambulance:": "fix",
+	"📝": "docs", ":memo:": "docs",
+	"♻": "refactor", ":recycle:": "refactor",
+	"⚡": "perf", ":zap:": "perf",
+	"✅": "test", ":white_check_mark:": "test",
+	"💄": "style", ":lipstick:": "style",
+	"👷": "ci", ":construction_worker:": "ci",
+	"📦": "build", ":package:": "build",
+	"🔧": "chore", ":wrench:": "chore",
+	"⬆": "chore", ":arrow_up:": "chore",
+	"⏪": "revert", ":rewind:": "revert",
+	"💥": "feat!", ":boom:": "feat!",
+}
+
+var (
+	angularRegexp  = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?\s*:\s*(\S.*)$`)
+	bracketsRegexp = regexp.MustCompile(`^\[([A-Za-z]+)\]\s*(\S.*)$`)
+	gitmojiRegexp  = regexp.MustCompile(`^(:[a-z0-9_+-]+:|\S+)\s+(\S.*)$`)
+	jiraRegexp     = regexp.MustCompile(`^([A-Z][A-Z0-9]+-[0-9]+)\s*[:\-]?\s+(\S.*)$`)
+)
+
+// normalizeType lowercases the given type, mapping it to the conventional one.
+func normalizeType(t string) string {
+	t = strings.ToLower(t)
+	if alias, ok := typeAliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+// ConvertAngular converts the headers in the style of the Angular commits before v1.0 (eg., "Feature($http) : add interceptors").
+//
+// It normalizes the type, the spaces around the colon, and drops the "*" scope Angular used for changes across the board.
+func ConvertAngular(header string) (Draft, bool) {
+	match := angularRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return Draft{}, false
+	}
+	d := Draft{Type: normalizeType(match[1]), Scope: strings.TrimSpace(match[2]), Description: match[3]}
+	if d.Scope == "*" {
+		d.Scope = ""
+	}
+
+	return d, true
+}
+
+// ConvertBrackets converts the headers starting with a type between brackets (eg., "[FIX] crash on empty payloads").
+func ConvertBrackets(header string) (Draft, bool) {
+	match := bracketsRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return Draft{}, false
+	}
+
+	return Draft{Type: normalizeType(match[1]), Description: match[2]}, true
+}
+
+// ConvertGitmoji converts the headers starting with a gitmoji, either an emoji or its code (eg., ":bug: crash on empty payloads").
+func ConvertGitmoji(header string) (Draft, bool) {
+	match := gitmojiRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return Draft{}, false
+	}
+	// Drop the variation selector some emojis carry
+	t, ok := gitmojis[strings.TrimSuffix(match[1], "️")]
+	if !ok {
+		return Draft{}, false
+	}
+	d := Draft{Type: strings.TrimSuffix(t, "!"), Breaking: strings.HasSuffix(t, "!"), Description: match[2]}
+
+	return d, true
+}
+
+// ConvertJira converts the headers starting with a JIRA issue key (eg., "ABC-123: Fix crash on empty payloads").
+//
+// It infers the type from the first verb of the description, unless the rest of the header is conventional,
+// and moves the issue key into a "Refs" trailer.
+func ConvertJira(header string) (Draft, bool) {
+	match := jiraRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return Draft{}, false
+	}
+	d, ok := ConvertAngular(match[2])
+	if !ok {
+		t, inferred := inferType(match[2])
+		if !inferred {
+			return Draft{}, false
+		}
+		d = Draft{Type: t, Description: match[2]}
+	}
+	d.Trailers = []Trailer{{Token: "Refs", Value: match[1]}}
+
+	return d, true
+}
+
+// Convert rewrites a commit message written in another convention into conventional form.
+//
+// It returns the input as is when a machine configured with the given options already accepts it.
+// Otherwise, it rewrites the header with the first of the given converters (or DefaultConverters)
+// producing a commit message the machine accepts, keeping the rest of the input.
+// It returns the error of the input when no converter fits.
+func Convert(input []byte, converters []Converter, options ...conventionalcommits.MachineOption) ([]byte, error) {
+	m := NewMachine(options...)
+	_, err := m.Parse(input)
+	if err == nil {
+		return input, nil
+	}
+	if converters == nil {
+		converters = DefaultConverters
+	}
+
+	header, rest := input, []byte{}
+	if i := bytes.IndexByte(input, '\n'); i >= 0 {
+		header, rest = input[:i], input[i:]
+	}
+	for _, convert := range converters {
+		d, ok := convert(strings.TrimSpace(string(header)))
+		if !ok {
+			continue
+		}
+		out := append([]byte(Draft{Type: d.Type, Scope: d.Scope, Breaking: d.Breaking, Description: d.Description}.String()), rest...)
+		out = appendTrailers(m, out, d.Trailers)
+		if _, e := m.Parse(out); e == nil {
+			return out, nil
+		}
+	}
+
+	return input, err
+}
+
+// appendTrailers appends the given trailers to the commit message, joining its footer trailers, if any.
+func appendTrailers(m conventionalcommits.Machine, input []byte, trailers []Trailer) []byte {
+	if len(trailers) == 0 {
+		return input
+	}
+	out := bytes.TrimRight(input, "\n")
+	sep := "\n\n"
+	if msg, _ := m.Parse(out); msg != nil && msg.HasFooter() {
+		sep = "\n"
+	}
+	for _, t := range trailers {
+		out = append(out, sep+t.Token+": "+t.Value...)
+		sep = "\n"
+	}
+
+	return out
+}
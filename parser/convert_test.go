@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	conventional := WithTypes(conventionalcommits.TypesConventional)
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{"fix: crash", "fix: crash"},
+		{"Feature($http) : add interceptors", "feat($http): add interceptors"},
+		{"Chore(*) : cut v0.9.0", "chore: cut v0.9.0"},
+		{"[FIX] crash on empty payloads\n\nbody", "fix: crash on empty payloads\n\nbody"},
+		{"[Feature] dark mode", "feat: dark mode"},
+		{":bug: crash on empty payloads", "fix: crash on empty payloads"},
+		{"✨ dark mode", "feat: dark mode"},
+		{"♻️ extract the lexer", "refactor: extract the lexer"},
+		{"💥 drop v1", "feat!: drop v1"},
+		{"ABC-123: Fix crash on empty payloads", "fix: Fix crash on empty payloads\n\nRefs: ABC-123"},
+		{"ABC-123 docs(readme): typo\n\nbody", "docs(readme): typo\n\nbody\n\nRefs: ABC-123"},
+		{"ABC-123 - Add endpoint\n\nSigned-off-by: Leo", "feat: Add endpoint\n\nSigned-off-by: Leo\nRefs: ABC-123"},
+	}
+	for _, tc := range cases {
+		out, err := Convert([]byte(tc.input), nil, conventional)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.output, string(out), tc.input)
+	}
+
+	// Converters only apply when the outcome is valid
+	_, err := Convert([]byte("[WIP] dark mode"), nil, conventional)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "[", 0))
+	_, err = Convert([]byte(":bug: crash"), []Converter{ConvertBrackets}, conventional)
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorpus(t *testing.T) {
+	valid := cctesting.ValidExamples()
+	invalid := cctesting.InvalidExamples()
+	assert.NotEmpty(t, valid)
+	assert.NotEmpty(t, invalid)
+	assert.Len(t, cctesting.Examples(), len(valid)+len(invalid))
+
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+	for _, e := range cctesting.Examples() {
+		_, err := p.Parse(e.Message)
+		assert.Equal(t, e.Valid, err == nil, e.Name)
+	}
+
+	// The corpus is also a file system, for ParseFS
+	report, err := ParseFS(cctesting.Corpus(), "valid/*.txt", WithTypes(conventionalcommits.TypesConventional))
+	assert.Nil(t, err)
+	assert.Len(t, report, len(valid))
+	_, err = fs.Stat(cctesting.Corpus(), "valid/valid-with-scope.txt")
+	assert.Nil(t, err)
+}
+
+func TestGenerate(t *testing.T) {
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+	for _, profile := range cctesting.Profiles {
+		messages := cctesting.Generate(profile, 200, 1)
+		assert.Len(t, messages, 200)
+		assert.Equal(t, messages, cctesting.Generate(profile, 200, 1), profile.Name)
+		for _, m := range messages {
+			_, err := p.Parse(m)
+			assert.Nil(t, err, "%s: %q", profile.Name, m)
+		}
+	}
+
+	for _, m := range cctesting.Generate(cctesting.ProfileHeaderOnly, 20, 2) {
+		assert.NotContains(t, string(m), "\n")
+	}
+}
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCO(t *testing.T) {
+	parse := func(input string) *conventionalcommits.ConventionalCommit {
+		msg, err := NewMachine().Parse([]byte(input))
+		assert.Nil(t, err, input)
+		return msg.(*conventionalcommits.ConventionalCommit)
+	}
+	leo := conventionalcommits.Identity{Name: "Leo Di Donato", Email: "leo@example.com"}
+	ana := conventionalcommits.Identity{Name: "Ana", Email: "ana@example.com"}
+
+	i, err := conventionalcommits.ParseIdentity(" Leo Di Donato <leo@example.com> ")
+	assert.Nil(t, err)
+	assert.Equal(t, leo, i)
+	assert.Equal(t, "Leo Di Donato <leo@example.com>", i.String())
+	for _, invalid := range []string{"Leo", "<leo@example.com>", "Leo <leo>", "Leo <leo@example.com", "Leo <leo@example.com> x"} {
+		_, err := conventionalcommits.ParseIdentity(invalid)
+		assert.Error(t, err, invalid)
+	}
+	assert.True(t, leo.Matches(conventionalcommits.Identity{Email: "LEO@example.com"}))
+	assert.False(t, leo.Matches(conventionalcommits.Identity{Name: "Leo", Email: "leo@example.com"}))
+
+	c := parse("fix: typo\n\nSigned-off-by: Leo Di Donato <leo@example.com>\nSigned-off-by: Ana <ana@example.com>")
+	assert.Equal(t, []conventionalcommits.Identity{leo, ana}, c.SignOffs())
+	assert.Nil(t, c.VerifyDCO(conventionalcommits.Identity{}))
+	assert.Nil(t, c.VerifyDCO(ana))
+	assert.EqualError(t, c.VerifyDCO(conventionalcommits.Identity{Name: "Bob", Email: "bob@example.com"}), "missing 'Signed-off-by' footer trailer of the author Bob <bob@example.com>")
+
+	err = parse("fix: typo\n\nRefs: #1").VerifyDCO(leo)
+	assert.True(t, errors.Is(err, conventionalcommits.ErrMissingSignOff))
+
+	c = parse("fix: typo\n\nSigned-off-by: Leo Di Donato <leo@example.com>\nSigned-off-by: Ana")
+	assert.Equal(t, []conventionalcommits.Identity{leo}, c.SignOffs())
+	assert.EqualError(t, c.VerifyDCO(leo), `malformed 'Signed-off-by' footer trailer: invalid identity "Ana": expecting 'Name <email>'`)
+}
@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnostics(t *testing.T) {
+	res := newMachine().ParseResult([]byte("fix x"))
+	assert.Equal(t, []conventionalcommits.Diagnostic{
+		{
+			Code:       "colon",
+			Severity:   conventionalcommits.SeverityError,
+			Range:      conventionalcommits.Span{Start: 3, End: 4},
+			Position:   conventionalcommits.Position{Line: 1, Column: 4, Offset: 3},
+			Message:    "expecting colon (':') character, got ' ' character",
+			Suggestion: "insert ':' after 'fix'",
+		},
+	}, res.Diagnostics())
+
+	res = newMachine().ParseResult([]byte("fix"))
+	assert.Equal(t, []conventionalcommits.Diagnostic{
+		{
+			Code:     "early-exit",
+			Severity: conventionalcommits.SeverityError,
+			Range:    conventionalcommits.Span{Start: 2, End: 3},
+			Position: conventionalcommits.Position{Line: 1, Column: 3, Offset: 2},
+			Message:  "early exit after 'x' character",
+		},
+	}, res.Diagnostics())
+
+	res = newMachine(WithHeaderLimit(4)).ParseResult([]byte("fix: abc"))
+	d := res.Diagnostics()
+	assert.Len(t, d, 1)
+	assert.Equal(t, "header-too-long", d[0].Code)
+	assert.Equal(t, conventionalcommits.Span{Start: 4, End: 4}, d[0].Range)
+
+	res = newMachine().ParseResult([]byte("fix: ok"))
+	assert.Empty(t, res.Diagnostics())
+}
+
+func TestNewDiagnostic(t *testing.T) {
+	d := conventionalcommits.NewDiagnostic(errors.New("oops"), conventionalcommits.SeverityWarning)
+	assert.Equal(t, conventionalcommits.Diagnostic{Code: "unknown", Severity: conventionalcommits.SeverityWarning, Message: "oops"}, d)
+	assert.Equal(t, "warning", d.Severity.String())
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionary(t *testing.T) {
+	d := conventionalcommits.NewDictionary(0.5)
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional), WithMultipleScopes())
+	for _, i := range []string{"fix(api): x", "fix(api): x", "fix(api): x", "feat(cli,parser): x", "fix(parser): x"} {
+		m, err := p.Parse([]byte(i))
+		assert.Nil(t, err)
+		d.Add(m)
+	}
+
+	assert.Equal(t, []conventionalcommits.Suggestion{
+		{Value: "fix", Score: 1 + 0.25 + 0.125 + 0.0625},
+		{Value: "feat", Score: 0.5},
+	}, d.Types(""))
+	assert.Equal(t, []conventionalcommits.Suggestion{
+		{Value: "parser", Score: 1.5},
+		{Value: "cli", Score: 0.5},
+		{Value: "api", Score: 0.25 + 0.125 + 0.0625},
+	}, d.Scopes(""))
+	assert.Equal(t, []string{"feat"}, values(d.Types("fe")))
+	assert.Empty(t, d.Scopes("x"))
+
+	// Without decay, scores are usage counts
+	d = conventionalcommits.NewDictionary(1)
+	for _, i := range []string{"fix(api): x", "fix(api): x", "feat(cli): x"} {
+		m, _ := p.Parse([]byte(i))
+		d.Add(m)
+	}
+	assert.Equal(t, []conventionalcommits.Suggestion{{Value: "api", Score: 2}, {Value: "cli", Score: 1}}, d.Scopes(""))
+}
+
+func values(suggestions []conventionalcommits.Suggestion) []string {
+	res := []string{}
+	for _, s := range suggestions {
+		res = append(res, s.Value)
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	parse := func(input string) *conventionalcommits.ConventionalCommit {
+		msg, err := NewMachine().Parse([]byte(input))
+		assert.Nil(t, err, input)
+		return msg.(*conventionalcommits.ConventionalCommit)
+	}
+	str := func(s string) *string {
+		return &s
+	}
+
+	a := parse("fix(api): typo\n\nbody\n\nRefs: #1\nRefs: #2\nAcked-by: Y")
+	assert.True(t, conventionalcommits.Equal(a, a))
+	assert.True(t, conventionalcommits.Equal(a, parse("fix(api): typo\n\nbody\n\nAcked-by: Y\nRefs: #1\nRefs: #2")))
+	assert.Empty(t, conventionalcommits.Diff(a, a.Normalize()))
+
+	b := parse("feat!: typo\n\nRefs: #1\nRefs: #3\nRefs: #4\nReviewed-by: Z")
+	assert.False(t, conventionalcommits.Equal(a, b))
+	assert.Equal(t, []conventionalcommits.MessageChange{
+		{Field: "type", Before: str("fix"), After: str("feat")},
+		{Field: "scope", Before: str("api")},
+		{Field: "exclamation", Before: str("false"), After: str("true")},
+		{Field: "body", Before: str("body")},
+		{Field: "footer", Token: "acked-by", Before: str("Y")},
+		{Field: "footer", Token: "refs", Index: 1, Before: str("#2"), After: str("#3")},
+		{Field: "footer", Token: "refs", Index: 2, After: str("#4")},
+		{Field: "footer", Token: "reviewed-by", After: str("Z")},
+	}, conventionalcommits.Diff(a, b))
+
+	c, err := a.WithDescription("handle empty payloads")
+	assert.Nil(t, err)
+	assert.Equal(t, []conventionalcommits.MessageChange{
+		{Field: "description", Before: str("typo"), After: str("handle empty payloads")},
+	}, conventionalcommits.Diff(a, c))
+}
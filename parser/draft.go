@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Trailer represents a footer trailer of a draft commit message.
+type Trailer struct {
+	Token string
+	Value string
+}
+
+// Draft represents the parts of a commit message to build, like scripts and bots do.
+type Draft struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+	Body        string
+	Trailers    []Trailer
+}
+
+// String renders the draft as a commit message, without validating it.
+func (d Draft) String() string {
+	var b strings.Builder
+	b.WriteString(d.Type)
+	if d.Scope != "" {
+		b.WriteString("(" + d.Scope + ")")
+	}
+	if d.Breaking {
+		b.WriteString("!")
+	}
+	b.WriteString(": " + d.Description)
+	if body := strings.TrimSpace(d.Body); body != "" {
+		b.WriteString("\n\n" + body)
+	}
+	for i, t := range d.Trailers {
+		if i == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + t.Token + ": " + t.Value)
+	}
+
+	return b.String()
+}
+
+// Build renders the draft as a commit message, ready for "git commit -F -",
+// and validates it with a machine configured with the given options.
+func (d Draft) Build(options ...conventionalcommits.MachineOption) ([]byte, error) {
+	out := []byte(d.String())
+	if _, err := NewMachine(options...).Parse(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDraft(t *testing.T) {
+	d := Draft{
+		Type:        "feat",
+		Scope:       "api",
+		Breaking:    true,
+		Description: "drop v1 endpoints",
+		Body:        "The v1 endpoints were deprecated a year ago.\n",
+		Trailers:    []Trailer{{Token: "BREAKING CHANGE", Value: "v1 is gone"}, {Token: "Refs", Value: "#12"}},
+	}
+	out, err := d.Build(WithTypes(conventionalcommits.TypesConventional))
+	assert.Nil(t, err)
+	assert.Equal(t, "feat(api)!: drop v1 endpoints\n\nThe v1 endpoints were deprecated a year ago.\n\nBREAKING CHANGE: v1 is gone\nRefs: #12", string(out))
+
+	assert.Equal(t, "fix: typo\n\nRefs: #1", Draft{Type: "fix", Description: "typo", Trailers: []Trailer{{Token: "Refs", Value: "#1"}}}.String())
+
+	_, err = Draft{Type: "feature", Description: "x"}.Build()
+	assert.EqualError(t, err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, "u", 4))
+	_, err = Draft{Type: "fix", Description: "x"}.Build(WithRequiredScope())
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// ErrDuplicateFooter tells the user that a footer trailer repeats the token of a previous one.
+const ErrDuplicateFooter = "duplicate '%s' footer trailer"
+
+// checkDuplicateFooter applies the policy about the footer trailers repeating a token to the current footer trailer.
+//
+// It remembers the first duplicate, so that checkRules can report it after the machine ran, when the policy rejects them.
+func (m *machine) checkDuplicateFooter(output *conventionalCommit) {
+	n := len(output.footers[m.currentFooterKey])
+	if n < 2 {
+		return
+	}
+
+	switch m.duplicateFooterPolicy {
+	case conventionalcommits.DuplicateFootersError:
+		if m.duplicateFooter == nil {
+			span := conventionalcommits.Span{Start: m.footerTokenStart, End: m.footerSepStart}
+			m.duplicateFooter = m.emitErrorAt(span, ErrDuplicateFooter, string(span.Text(m.data)), m.footerTokenStart)
+		}
+	case conventionalcommits.DuplicateFootersFirstWins:
+		output.dropFooter(m.currentFooterKey, n-1)
+		m.unmapFooter(m.currentFooterKey, n-1)
+	case conventionalcommits.DuplicateFootersLastWins:
+		output.dropFooter(m.currentFooterKey, 0)
+		m.unmapFooter(m.currentFooterKey, 0)
+	}
+}
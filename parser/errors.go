@@ -0,0 +1,203 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// codes maps the error message templates to the codes of the corresponding diagnostics.
+var codes = map[string]string{
+	ErrType:                        "type",
+	ErrTypeIncomplete:              "type-incomplete",
+	ErrColon:                       "colon",
+	ErrScope:                       "scope",
+	ErrScopeIncomplete:             "scope-incomplete",
+	ErrEmpty:                       "empty",
+	ErrEarly:                       "early-exit",
+	ErrDescriptionInit:             "description-init",
+	ErrDescription:                 "description",
+	ErrNewline:                     "newline",
+	ErrMissingBlankLineAtBeginning: "missing-blank-line",
+	ErrTrailer:                     "trailer",
+	ErrTrailerIncomplete:           "trailer-incomplete",
+	ErrScopeRequired:               "scope-required",
+	ErrHeaderTooLong:               "header-too-long",
+	ErrRewindBudget:                "rewind-budget",
+	ErrTypePattern:                 "type-pattern",
+	ErrTrivialDescription:          "trivial-description",
+	ErrHeaderLength:                "header-length",
+	ErrTrailingPeriod:              "trailing-period",
+	ErrDescriptionCase:             "description-case",
+	ErrTypeCase:                    "type-case",
+	ErrDuplicateFooter:             "duplicate-footer",
+	ErrChangeID:                    "change-id",
+	ErrChangeIDRequired:            "change-id-required",
+	ErrFooterNotAllowed:            "footer-not-allowed",
+	ErrNotText:                     "not-text",
+	ErrMissingColonSpace:           "missing-colon-space",
+	ErrTabSeparator:                "tab-separator",
+	ErrSpecDescriptionSpace:        "spec-item-5",
+	ErrSpecBodyBlankLine:           "spec-item-6",
+	ErrSpecFooterBlankLine:         "spec-item-8",
+	ErrSpecBreakingChangeCase:      "spec-item-15",
+}
+
+// Sentinel errors to match the errors of the parser with errors.Is, whatever their message and their position.
+var (
+	// ErrInvalidType matches the errors about the commit message type.
+	ErrInvalidType = errors.New("invalid commit message type")
+	// ErrInvalidScope matches the errors about a malformed scope.
+	ErrInvalidScope = errors.New("invalid scope")
+	// ErrMissingScope matches the errors about a required scope missing.
+	ErrMissingScope = errors.New("missing scope")
+	// ErrInvalidDescription matches the errors about the description.
+	ErrInvalidDescription = errors.New("invalid description")
+	// ErrInvalidTrailer matches the errors about a malformed or forbidden footer trailer.
+	ErrInvalidTrailer = errors.New("invalid footer trailer")
+	// ErrMissingChangeID matches the errors about a required Change-Id footer trailer missing.
+	ErrMissingChangeID = errors.New("missing Change-Id footer trailer")
+)
+
+// sentinels maps the sentinel errors to the message templates of the errors they match.
+var sentinels = map[error][]string{
+	ErrInvalidType:        {ErrType, ErrTypeIncomplete, ErrTypePattern, ErrTypeCase},
+	ErrInvalidScope:       {ErrScope, ErrScopeIncomplete},
+	ErrMissingScope:       {ErrScopeRequired},
+	ErrInvalidDescription: {ErrDescriptionInit, ErrDescription, ErrNewline, ErrTrivialDescription, ErrTrailingPeriod, ErrDescriptionCase, ErrMissingColonSpace, ErrTabSeparator, ErrSpecDescriptionSpace},
+	ErrInvalidTrailer:     {ErrTrailer, ErrTrailerIncomplete, ErrSpecFooterBlankLine, ErrSpecBreakingChangeCase, ErrDuplicateFooter, ErrChangeID, ErrFooterNotAllowed},
+	ErrMissingChangeID:    {ErrChangeIDRequired},
+}
+
+// parseError represents an error occurring at a given column while parsing.
+//
+// The last of its arguments is the column.
+type parseError struct {
+	template   string
+	args       []interface{}
+	span       conventionalcommits.Span
+	position   conventionalcommits.Position
+	suggestion string
+	formatter  conventionalcommits.ErrorFormatter
+}
+
+// TypeError is the error about the commit message type.
+type TypeError struct{ parseError }
+
+// ScopeError is the error about the scope.
+type ScopeError struct{ parseError }
+
+// HeaderError is the error about the structure of the header, like a missing colon.
+type HeaderError struct{ parseError }
+
+// DescriptionError is the error about the description.
+type DescriptionError struct{ parseError }
+
+// BodyError is the error about the body.
+type BodyError struct{ parseError }
+
+// TrailerError is the error about the footer trailers.
+type TrailerError struct{ parseError }
+
+// positioned represents the errors of the parser carrying the position where they occur.
+type positioned interface {
+	base() *parseError
+}
+
+// position computes the line and the column of the given byte offset of the given input.
+//
+// It computes where the lines of each input start once per parsing, or reuses the source map of the input, if any.
+func (m *machine) position(input []byte, offset int) conventionalcommits.Position {
+	if m.lines == nil || len(m.linesInput) != len(input) || len(input) > 0 && &m.linesInput[0] != &input[0] {
+		m.lines, m.linesInput = conventionalcommits.NewSourceMap(input), input
+	}
+	return m.lines.Position(offset)
+}
+
+// newParseError creates the error of the kind the given template is about, occurring in the given input.
+func (m *machine) newParseError(input []byte, span conventionalcommits.Span, template string, args []interface{}) error {
+	e := parseError{template: template, args: args, span: span}
+	e.position = m.position(input, e.Column())
+	e.suggestion = m.suggest(input, template, args)
+	e.formatter = m.errorFormatter
+	switch template {
+	case ErrType, ErrTypeIncomplete, ErrTypePattern, ErrTypeCase:
+		return &TypeError{e}
+	case ErrScope, ErrScopeIncomplete, ErrScopeRequired:
+		return &ScopeError{e}
+	case ErrColon, ErrEmpty, ErrEarly, ErrHeaderLength:
+		return &HeaderError{e}
+	case ErrMissingBlankLineAtBeginning, ErrSpecBodyBlankLine:
+		return &BodyError{e}
+	case ErrTrailer, ErrTrailerIncomplete, ErrSpecFooterBlankLine, ErrSpecBreakingChangeCase, ErrDuplicateFooter, ErrChangeID, ErrChangeIDRequired, ErrFooterNotAllowed:
+		return &TrailerError{e}
+	default:
+		return &DescriptionError{e}
+	}
+}
+
+// base returns the receiving error.
+func (e *parseError) base() *parseError {
+	return e
+}
+
+// Template returns the message template of the error (eg., ErrScope).
+func (e *parseError) Template() string {
+	return e.template
+}
+
+// Column returns the position where the error occurs.
+func (e *parseError) Column() int {
+	return e.args[len(e.args)-1].(int)
+}
+
+// Position returns the line and the column where the error occurs.
+func (e *parseError) Position() conventionalcommits.Position {
+	return e.position
+}
+
+// Suggestion returns how to fix the error, when known (eg., "add a blank line before the body").
+func (e *parseError) Suggestion() string {
+	return e.suggestion
+}
+
+// Span returns the part of the input the error is about.
+func (e *parseError) Span() conventionalcommits.Span {
+	return e.span
+}
+
+// Is tells whether the target is one of the sentinel errors matching the template of the error (eg., ErrInvalidType).
+func (e *parseError) Is(target error) bool {
+	for _, t := range sentinels[target] {
+		if t == e.template {
+			return true
+		}
+	}
+	return false
+}
+
+// Error returns the message of the error, followed by the column where it occurs, unless a custom formatter renders it.
+func (e *parseError) Error() string {
+	if e.formatter != nil {
+		return format(e.formatter, e.Diagnostic(), e.position, e.template, e.args[:len(e.args)-1]...)
+	}
+	return fmt.Sprintf(e.template+ColumnPositionTemplate, e.args...)
+}
+
+// format renders an error with the given custom formatter.
+func format(f conventionalcommits.ErrorFormatter, d conventionalcommits.Diagnostic, position conventionalcommits.Position, template string, args ...interface{}) string {
+	d.Position = position
+	return f(conventionalcommits.ErrorInfo{Diagnostic: d, Template: template, Args: args})
+}
+
+// Diagnostic converts the error into a diagnostic.
+func (e *parseError) Diagnostic() conventionalcommits.Diagnostic {
+	return conventionalcommits.Diagnostic{
+		Code:       codes[e.template],
+		Severity:   conventionalcommits.SeverityError,
+		Range:      e.span,
+		Message:    fmt.Sprintf(e.template, e.args[:len(e.args)-1]...),
+		Suggestion: e.suggestion,
+	}
+}
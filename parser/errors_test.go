@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedErrors(t *testing.T) {
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+
+	_, err := p.Parse([]byte("fiz: typo"))
+	var typeErr *TypeError
+	assert.True(t, errors.As(err, &typeErr))
+	assert.Equal(t, ErrType, typeErr.Template())
+	assert.Equal(t, 2, typeErr.Column())
+	assert.Equal(t, conventionalcommits.Span{Start: 2, End: 3}, typeErr.Span())
+
+	_, err = p.Parse([]byte("fix(a(): typo"))
+	var scopeErr *ScopeError
+	assert.True(t, errors.As(err, &scopeErr))
+	assert.False(t, errors.As(err, &typeErr))
+	// Messages stay the same
+	assert.EqualError(t, err, fmt.Sprintf(ErrScope+ColumnPositionTemplate, "(", 5))
+
+	_, err = p.Parse([]byte("fix typo"))
+	var headerErr *HeaderError
+	assert.True(t, errors.As(err, &headerErr))
+
+	_, err = p.Parse([]byte("fix:typo"))
+	var descrErr *DescriptionError
+	assert.True(t, errors.As(err, &descrErr))
+
+	_, err = p.Parse([]byte("fix: typo\nbody"))
+	var bodyErr *BodyError
+	assert.True(t, errors.As(err, &bodyErr))
+
+	_, err = p.Parse([]byte("fix: typo\n\nRefs: #1\n!"))
+	var trailerErr *TrailerError
+	assert.True(t, errors.As(err, &trailerErr))
+	assert.Equal(t, ErrTrailer, trailerErr.Template())
+
+	// Wrapping preserves them
+	wrapped := fmt.Errorf("linting: %w", err)
+	assert.True(t, errors.As(wrapped, &trailerErr))
+}
+
+func TestSentinelErrors(t *testing.T) {
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional), WithRequiredScope())
+
+	_, err := p.Parse([]byte("fiz: typo"))
+	assert.True(t, errors.Is(err, ErrInvalidType))
+	assert.False(t, errors.Is(err, ErrMissingScope))
+
+	_, err = p.Parse([]byte("fix: typo"))
+	assert.True(t, errors.Is(fmt.Errorf("linting: %w", err), ErrMissingScope))
+	assert.False(t, errors.Is(err, ErrInvalidScope))
+
+	_, err = p.Parse([]byte("fix(a(): typo"))
+	assert.True(t, errors.Is(err, ErrInvalidScope))
+
+	_, err = p.Parse([]byte("fix(a): typo\n\nRefs: #1\n!"))
+	assert.True(t, errors.Is(err, ErrInvalidTrailer))
+	assert.False(t, errors.Is(err, ErrInvalidType))
+
+	_, err = NewMachine(WithRequiredChangeID()).Parse([]byte("fix: typo"))
+	assert.True(t, errors.Is(err, ErrMissingChangeID))
+}
+
+func TestErrorPositions(t *testing.T) {
+	_, err := NewMachine().Parse([]byte("fix: café\n\nbody\n\nRefs: #1\n!"))
+	var trailerErr *TrailerError
+	assert.True(t, errors.As(err, &trailerErr))
+	assert.Equal(t, conventionalcommits.Position{Line: 6, Column: 1, Offset: 27}, trailerErr.Position())
+
+	// Columns count characters rather than bytes
+	_, err = NewMachine(WithNoTrailingPeriod()).Parse([]byte("fix: éàè."))
+	d := conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError)
+	assert.Equal(t, conventionalcommits.Position{Line: 1, Column: 9, Offset: 11}, d.Position)
+
+	// Positions refer to the original input, also when lenient options amend it
+	_, err = NewMachine(WithLenientColonSpace()).Parse([]byte("fix:typo\n\nRefs: #1\n!"))
+	assert.True(t, errors.As(err, &trailerErr))
+	assert.Equal(t, conventionalcommits.Position{Line: 4, Column: 1, Offset: 19}, trailerErr.Position())
+
+	_, err = NewMachine(WithHeaderLimit(4)).Parse([]byte("fix: abc"))
+	assert.Equal(t, conventionalcommits.Position{Line: 1, Column: 5, Offset: 4}, err.(conventionalcommits.Positioner).Position())
+}
+
+func TestErrorFormatter(t *testing.T) {
+	formatter := func(i conventionalcommits.ErrorInfo) string {
+		return fmt.Sprintf("%s@%d:%d: %s", i.Code, i.Position.Line, i.Position.Column, fmt.Sprintf(i.Template, i.Args...))
+	}
+	p := NewMachine(WithErrorFormatter(formatter))
+
+	_, err := p.Parse([]byte("fix(a(): typo"))
+	assert.EqualError(t, err, "scope@1:6: illegal '(' character in scope")
+	// The diagnostics stay the same
+	d := conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError)
+	assert.Equal(t, "illegal '(' character in scope", d.Message)
+
+	_, err = NewMachine(WithErrorFormatter(formatter), WithHeaderLimit(4)).Parse([]byte("fix: abc"))
+	assert.EqualError(t, err, "header-too-long@1:5: header longer than 4 bytes")
+
+	// Without formatter, the default rendering applies
+	_, err = NewMachine().Parse([]byte("fix(a(): typo"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrScope+ColumnPositionTemplate, "(", 5))
+}
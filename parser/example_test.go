@@ -23,9 +23,13 @@ func Example_minimal_withoutbody() {
 	//  Type: (string) (len=3) "fix",
 	//  Description: (string) (len=9) "something",
 	//  Scope: (*string)(<nil>),
+	//  MultipleScopes: ([]string) <nil>,
+	//  ScopePath: ([]string) <nil>,
 	//  Exclamation: (bool) true,
 	//  Body: (*string)(<nil>),
-	//  Footers: (map[string][]string) <nil>
+	//  Footers: (map[string][]string) <nil>,
+	//  OrderedFooters: ([]conventionalcommits.Footer) <nil>,
+	//  Annotations: (map[string]string) <nil>
 	// })
 	// there are breaking changes? true
 }
@@ -41,9 +45,13 @@ a blank line is mandatory to start the body part of the commit message!`)
 	//  Type: (string) (len=3) "fix",
 	//  Description: (string) (len=11) "description",
 	//  Scope: (*string)(<nil>),
+	//  MultipleScopes: ([]string) <nil>,
+	//  ScopePath: ([]string) <nil>,
 	//  Exclamation: (bool) false,
 	//  Body: (*string)(<nil>),
-	//  Footers: (map[string][]string) <nil>
+	//  Footers: (map[string][]string) <nil>,
+	//  OrderedFooters: ([]conventionalcommits.Footer) <nil>,
+	//  Annotations: (map[string]string) <nil>
 	// })
 	// missing a blank line: col=17
 }
@@ -64,9 +72,13 @@ typos fixed.`)
 	//  Type: (string) (len=3) "fix",
 	//  Description: (string) (len=1) "x",
 	//  Scope: (*string)(<nil>),
+	//  MultipleScopes: ([]string) <nil>,
+	//  ScopePath: ([]string) <nil>,
 	//  Exclamation: (bool) false,
 	//  Body: (*string)((len=86) "see the issue for details\n\nbut first a newline\nand then two blank lines:\n\ntypos fixed."),
-	//  Footers: (map[string][]string) <nil>
+	//  Footers: (map[string][]string) <nil>,
+	//  OrderedFooters: ([]conventionalcommits.Footer) <nil>,
+	//  Annotations: (map[string]string) <nil>
 	// })
 }
 
@@ -91,6 +103,8 @@ Refs #133`)
 	//  Type: (string) (len=3) "fix",
 	//  Description: (string) (len=27) "correct minor typos in code",
 	//  Scope: (*string)(<nil>),
+	//  MultipleScopes: ([]string) <nil>,
+	//  ScopePath: ([]string) <nil>,
 	//  Exclamation: (bool) false,
 	//  Body: (*string)((len=65) "see the issue [0] for details\non typos fixed.\n\n[0]: https://issue"),
 	//  Footers: (map[string][]string) (len=2) {
@@ -100,7 +114,20 @@ Refs #133`)
 	//   (string) (len=4) "refs": ([]string) (len=1) {
 	//    (string) (len=3) "133"
 	//   }
-	//  }
+	//  },
+	//  OrderedFooters: ([]conventionalcommits.Footer) (len=2) {
+	//   (conventionalcommits.Footer) {
+	//    Token: (string) (len=11) "reviewed-by",
+	//    Separator: (string) (len=2) ": ",
+	//    Value: (string) (len=1) "Z"
+	//   },
+	//   (conventionalcommits.Footer) {
+	//    Token: (string) (len=4) "refs",
+	//    Separator: (string) (len=2) " #",
+	//    Value: (string) (len=3) "133"
+	//   }
+	//  },
+	//  Annotations: (map[string]string) <nil>
 	// })
 }
 
@@ -113,8 +140,12 @@ func Example_breaking_freeformtype_with_scope() {
 	//  Type: (string) (len=3) "kvm",
 	//  Description: (string) (len=56) "Truncate base/index GPR value on address calc in !64-bit",
 	//  Scope: (*string)((len=4) "nvmx"),
+	//  MultipleScopes: ([]string) <nil>,
+	//  ScopePath: ([]string) <nil>,
 	//  Exclamation: (bool) true,
 	//  Body: (*string)(<nil>),
-	//  Footers: (map[string][]string) <nil>
+	//  Footers: (map[string][]string) <nil>,
+	//  OrderedFooters: ([]conventionalcommits.Footer) <nil>,
+	//  Annotations: (map[string]string) <nil>
 	// })
 }
@@ -0,0 +1,249 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	docsURL = "https://github.com/reviewpad/go-conventionalcommits"
+	specURL = "https://www.conventionalcommits.org/en/v1.0.0/#specification"
+)
+
+// Explanation documents the diagnostics with a given code.
+type Explanation struct {
+	// Code is the code of the diagnostics.
+	Code string
+	// Summary tells what the issue is and how to fix it.
+	Summary string
+	// URL points to the documentation of the issue.
+	URL string
+	// Bad is an example of commit message with the issue.
+	Bad string
+	// Good is the same example with the issue fixed.
+	Good string
+}
+
+// String renders the explanation for terminals.
+func (e Explanation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n\nSee %s\n", e.Code, e.Summary, e.URL)
+	if e.Bad != "" {
+		fmt.Fprintf(&b, "\nBad:\n%s\n", indent(e.Bad))
+	}
+	if e.Good != "" {
+		fmt.Fprintf(&b, "\nGood:\n%s\n", indent(e.Good))
+	}
+
+	return b.String()
+}
+
+// indent indents every line of the given text.
+func indent(text string) string {
+	return "    " + strings.ReplaceAll(text, "\n", "\n    ")
+}
+
+// Explain returns the explanation of the diagnostics with the given code.
+func Explain(code string) (Explanation, bool) {
+	e, ok := explanations[code]
+	return e, ok
+}
+
+var explanations = map[string]Explanation{
+	"type": {
+		Summary: "the type contains a character not allowed by the set of types in use.",
+		URL:     docsURL + "#types",
+		Bad:     "fiz: correct typo",
+		Good:    "fix: correct typo",
+	},
+	"type-incomplete": {
+		Summary: "the commit message ends within the type.",
+		URL:     docsURL + "#types",
+		Bad:     "fea",
+		Good:    "feat: add endpoint",
+	},
+	"colon": {
+		Summary: "the type, and the optional scope, must be followed by a colon.",
+		URL:     specURL,
+		Bad:     "fix correct typo",
+		Good:    "fix: correct typo",
+	},
+	"scope": {
+		Summary: "the scope contains an illegal character.",
+		URL:     docsURL + "#scopes",
+		Bad:     "fix(docs(): correct typo",
+		Good:    "fix(docs): correct typo",
+	},
+	"scope-incomplete": {
+		Summary: "the scope must be closed by a parenthesis.",
+		URL:     specURL,
+		Bad:     "fix(docs: correct typo",
+		Good:    "fix(docs): correct typo",
+	},
+	"empty": {
+		Summary: "the commit message is empty.",
+		URL:     specURL,
+		Good:    "fix: correct typo",
+	},
+	"early-exit": {
+		Summary: "the commit message ends before its header does.",
+		URL:     specURL,
+		Bad:     "fix(docs)",
+		Good:    "fix(docs): correct typo",
+	},
+	"description-init": {
+		Summary: "the colon must be followed by a space.",
+		URL:     specURL,
+		Bad:     "fix:correct typo",
+		Good:    "fix: correct typo",
+	},
+	"description": {
+		Summary: "the header must end with a description.",
+		URL:     specURL,
+		Bad:     "fix: ",
+		Good:    "fix: correct typo",
+	},
+	"newline": {
+		Summary: "the commit message contains an unexpected newline.",
+		URL:     specURL,
+		Bad:     "fix: \ncorrect typo",
+		Good:    "fix: correct typo",
+	},
+	"missing-blank-line": {
+		Summary: "a blank line must separate the description from the body.",
+		URL:     specURL,
+		Bad:     "fix: correct typo\nin the readme",
+		Good:    "fix: correct typo\n\nin the readme",
+	},
+	"trailer": {
+		Summary: "the footer trailer contains an illegal character.",
+		URL:     specURL,
+		Bad:     "fix: correct typo\n\nRefs: #1\n!",
+		Good:    "fix: correct typo\n\nRefs: #1",
+	},
+	"trailer-incomplete": {
+		Summary: "the footer trailer ends before its value.",
+		URL:     specURL,
+		Bad:     "fix: correct typo\n\nRefs: #1\nReviewed-by",
+		Good:    "fix: correct typo\n\nRefs: #1\nReviewed-by: Leo",
+	},
+	"scope-required": {
+		Summary: "the scope is mandatory (see WithRequiredScope).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo",
+		Good:    "fix(docs): correct typo",
+	},
+	"header-too-long": {
+		Summary: "the header exceeds the limit protecting the parser from pathological inputs (see WithHeaderLimit).",
+		URL:     docsURL + "#header-limit",
+	},
+	"rewind-budget": {
+		Summary: "the parser backtracked too much while looking for the footer trailers (see WithRewindBudget).",
+		URL:     docsURL + "#header-limit",
+	},
+	"type-pattern": {
+		Summary: "the type does not match the regular expression in use (see WithTypePattern).",
+		URL:     docsURL + "#types",
+	},
+	"trivial-description": {
+		Summary: "the description carries too little information (see WithTrivialDescriptionRule).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: stuff",
+		Good:    "fix: correct typo in the installation steps",
+	},
+	"not-text": {
+		Summary: "the input is binary data rather than text.",
+		URL:     docsURL + "#header-limit",
+	},
+	"missing-colon-space": {
+		Summary: "the colon must be followed by a space (see WithLenientColonSpace).",
+		URL:     docsURL + "#lenient-mode",
+		Bad:     "fix:correct typo",
+		Good:    "fix: correct typo",
+	},
+	"tab-separator": {
+		Summary: "a space, rather than a tab, must follow the colon (see WithDescriptionSeparator).",
+		URL:     docsURL + "#lenient-mode",
+		Bad:     "fix:\tcorrect typo",
+		Good:    "fix: correct typo",
+	},
+	"header-length": {
+		Summary: "the first line is longer than the limit (see WithMaxHeaderLength).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct the typo in the installation steps of the readme and in the docs",
+		Good:    "fix: correct typo in the installation steps",
+	},
+	"trailing-period": {
+		Summary: "the description must not end with a period (see WithNoTrailingPeriod).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo.",
+		Good:    "fix: correct typo",
+	},
+	"description-case": {
+		Summary: "the first letter of the description has the wrong case (see WithDescriptionCase).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: Correct typo",
+		Good:    "fix: correct typo",
+	},
+	"duplicate-footer": {
+		Summary: "a footer trailer repeats the token of a previous one (see WithDuplicateFooterPolicy).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo\n\nChange-Id: I1a2b\nChange-Id: I3c4d",
+		Good:    "fix: correct typo\n\nChange-Id: I1a2b",
+	},
+	"footer-not-allowed": {
+		Summary: "the token of a footer trailer is not among the allowed ones (see WithAllowedFooters).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo\n\nFixes: #12",
+		Good:    "fix: correct typo\n\nRefs: #12",
+	},
+	"change-id": {
+		Summary: "the value of a Change-Id footer trailer is not a valid Gerrit Change-Id (see WithRequiredChangeID).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo\n\nChange-Id: I1a2b",
+		Good:    "fix: correct typo\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940",
+	},
+	"change-id-required": {
+		Summary: "the Change-Id footer trailer Gerrit tracks the changes with is mandatory (see WithRequiredChangeID).",
+		URL:     docsURL + "#rules",
+		Bad:     "fix: correct typo",
+		Good:    "fix: correct typo\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940",
+	},
+	"type-case": {
+		Summary: "the type should be lowercase, even though the specification allows any case (see WithStyleWarnings).",
+		URL:     docsURL + "#rules",
+		Bad:     "FIX: correct typo",
+		Good:    "fix: correct typo",
+	},
+	"spec-item-5": {
+		Summary: "a single space must follow the colon (see WithStrictSpec).",
+		URL:     specURL,
+		Bad:     "fix:  correct typo",
+		Good:    "fix: correct typo",
+	},
+	"spec-item-6": {
+		Summary: "a single blank line must separate the description from the body (see WithStrictSpec).",
+		URL:     specURL,
+		Bad:     "fix: correct typo\n\n\nbody",
+		Good:    "fix: correct typo\n\nbody",
+	},
+	"spec-item-8": {
+		Summary: "a single blank line must precede the footer trailers (see WithStrictSpec).",
+		URL:     specURL,
+		Bad:     "fix: correct typo\n\nbody\n\n\nRefs: #1",
+		Good:    "fix: correct typo\n\nbody\n\nRefs: #1",
+	},
+	"spec-item-15": {
+		Summary: "the breaking change token must be uppercase (see WithStrictSpec).",
+		URL:     specURL,
+		Bad:     "feat: drop v1\n\nBreaking-Change: v1 is gone",
+		Good:    "feat: drop v1\n\nBREAKING CHANGE: v1 is gone",
+	},
+}
+
+func init() {
+	for code, e := range explanations {
+		e.Code = code
+		explanations[code] = e
+	}
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainAllCodes(t *testing.T) {
+	for _, code := range codes {
+		e, ok := Explain(code)
+		assert.True(t, ok, code)
+		assert.Equal(t, code, e.Code)
+		assert.NotEmpty(t, e.Summary, code)
+		assert.NotEmpty(t, e.URL, code)
+	}
+
+	_, ok := Explain("unknown")
+	assert.False(t, ok)
+}
+
+func TestExplainExamples(t *testing.T) {
+	options := map[string][]conventionalcommits.MachineOption{
+		"scope-required":      {WithRequiredScope()},
+		"trivial-description": {WithTrivialDescriptionRule(conventionalcommits.TrivialDescriptionRule{MinWords: 2, Error: true})},
+		"header-length":       {WithMaxHeaderLength(72)},
+		"trailing-period":     {WithNoTrailingPeriod()},
+		"description-case":    {WithDescriptionCase(conventionalcommits.CaseLowerFirst)},
+		"duplicate-footer":    {WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersError)},
+		"footer-not-allowed":  {WithAllowedFooters([]string{"BREAKING CHANGE", "Refs", "Signed-off-by"})},
+		"change-id":           {WithRequiredChangeID()},
+		"change-id-required":  {WithRequiredChangeID()},
+		"spec-item-5":         {WithStrictSpec()},
+		"spec-item-6":         {WithStrictSpec()},
+		"spec-item-8":         {WithStrictSpec()},
+		"spec-item-15":        {WithStrictSpec()},
+	}
+	for code, e := range explanations {
+		if e.Bad == "" {
+			continue
+		}
+		opts := append([]conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesConventional)}, options[code]...)
+		_, err := NewMachine(opts...).Parse([]byte(e.Good))
+		assert.Nil(t, err, code)
+
+		if code == "missing-colon-space" || code == "tab-separator" || code == "type-case" {
+			// The lenient options, and the style warnings, turn these into warnings
+			continue
+		}
+		_, err = NewMachine(opts...).Parse([]byte(e.Bad))
+		if assert.Error(t, err, code) {
+			assert.Equal(t, code, conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError).Code)
+		}
+	}
+}
+
+func TestExplanationString(t *testing.T) {
+	e, _ := Explain("trailing-period")
+	assert.Equal(t, `trailing-period: the description must not end with a period (see WithNoTrailingPeriod).
+
+See https://github.com/reviewpad/go-conventionalcommits#rules
+
+Bad:
+    fix: correct typo.
+
+Good:
+    fix: correct typo
+`, e.String())
+}
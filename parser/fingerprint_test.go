@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint(t *testing.T) {
+	f := classify(t, "fix(api): handle empty payloads\n\nRefs: #12\nReviewed-by: Leo").Fingerprint()
+	assert.Len(t, f, 64)
+
+	same := []string{
+		"fix(api): handle empty payloads\n\nRefs: #12\nReviewed-by: Leo",
+		"FIX(API): handle   empty payloads\n\nReviewed-by: Leo\nRefs: #12",
+		"fix(api): handle empty payloads\n\n(cherry picked from commit 0a1b2c3)\n\nRefs: #12\nReviewed-by:  Leo",
+	}
+	for _, input := range same {
+		assert.Equal(t, f, classify(t, input).Fingerprint(), input)
+	}
+
+	different := []string{
+		"fix(api): handle empty payload\n\nRefs: #12\nReviewed-by: Leo",
+		"fix(cli): handle empty payloads\n\nRefs: #12\nReviewed-by: Leo",
+		"fix(api)!: handle empty payloads\n\nRefs: #12\nReviewed-by: Leo",
+		"feat(api): handle empty payloads\n\nRefs: #12\nReviewed-by: Leo",
+		"fix(api): handle empty payloads\n\nRefs: #13\nReviewed-by: Leo",
+		"fix(api): handle empty payloads\n\nRefs: #12",
+	}
+	for _, input := range different {
+		assert.NotEqual(t, f, classify(t, input).Fingerprint(), input)
+	}
+}
@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// maxFixes is the maximum number of errors Fix fixes in a commit message.
+const maxFixes = 16
+
+// Fix amends the commit message until a machine configured with the given options accepts it,
+// fixing one error after another the way their suggestions tell.
+//
+// It returns the amended commit message, and the first error it can't fix, if any.
+// The fixes only touch the erroneous parts of the commit message.
+func Fix(input []byte, options ...conventionalcommits.MachineOption) ([]byte, error) {
+	m := NewMachine(options...)
+	out := append([]byte{}, input...)
+	for i := 0; ; i++ {
+		_, err := m.Parse(out)
+		if err == nil {
+			return out, nil
+		}
+		p, ok := err.(positioned)
+		if !ok || i == maxFixes {
+			return out, err
+		}
+		edit, ok := m.(*machine).fixEdit(out, p.base())
+		if !ok {
+			return out, err
+		}
+		if out, err = conventionalcommits.Splice(out, edit); err != nil {
+			return out, err
+		}
+	}
+}
+
+// fixEdit returns the edit of the input fixing the given error, if known.
+func (m *machine) fixEdit(input []byte, e *parseError) (conventionalcommits.Edit, bool) {
+	col := e.Column()
+	insert := func(text string) (conventionalcommits.Edit, bool) {
+		return conventionalcommits.Edit{Span: conventionalcommits.Span{Start: col, End: col}, Text: text}, true
+	}
+	replace := func(span conventionalcommits.Span, text string) (conventionalcommits.Edit, bool) {
+		return conventionalcommits.Edit{Span: span, Text: text}, true
+	}
+
+	switch e.template {
+	case ErrColon:
+		switch {
+		case col < len(input) && input[col] == ' ':
+			return insert(":")
+		case col > 0 && (input[col-1] == ')' || input[col-1] == '!'):
+			return insert(": ")
+		}
+	case ErrDescriptionInit:
+		if col < len(input) && input[col] == '\t' {
+			return replace(e.span, " ")
+		}
+		if col < len(input) && input[col] != '\n' {
+			return insert(" ")
+		}
+	case ErrMissingBlankLineAtBeginning:
+		return insert("\n")
+	case ErrSpecBodyBlankLine, ErrSpecFooterBlankLine:
+		return replace(conventionalcommits.Span{Start: col, End: col + 1}, "")
+	case ErrSpecDescriptionSpace, ErrTrailingPeriod:
+		return replace(e.span, "")
+	case ErrSpecBreakingChangeCase:
+		return replace(e.span, strings.ToUpper(string(e.span.Text(input))))
+	case ErrTypeCase:
+		return replace(e.span, strings.ToLower(string(e.span.Text(input))))
+	case ErrDuplicateFooter, ErrFooterNotAllowed:
+		// Remove the whole line of the trailer, with the newlines before it when it is the last line
+		end := bytes.IndexByte(input[e.span.Start:], '\n')
+		if end < 0 {
+			start := e.span.Start
+			for start > 0 && input[start-1] == '\n' {
+				start--
+			}
+			return replace(conventionalcommits.Span{Start: start, End: len(input)}, "")
+		}
+		return replace(conventionalcommits.Span{Start: e.span.Start, End: e.span.Start + end + 1}, "")
+	case ErrDescriptionCase:
+		r, _ := utf8.DecodeRune(e.span.Text(input))
+		if unicode.IsUpper(r) {
+			return replace(e.span, string(unicode.ToLower(r)))
+		}
+		return replace(e.span, string(unicode.ToUpper(r)))
+	case ErrType, ErrTypeIncomplete:
+		if t, ok := m.closestType(input); ok {
+			return replace(conventionalcommits.Span{Start: 0, End: invalidTypeEnd(input)}, t)
+		}
+	}
+
+	return conventionalcommits.Edit{}, false
+}
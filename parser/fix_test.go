@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFix(t *testing.T) {
+	cases := []struct {
+		input   string
+		options []conventionalcommits.MachineOption
+		output  string
+	}{
+		{"fix: ok", nil, "fix: ok"},
+		{"fix typo", nil, "fix: typo"},
+		{"fix(api)typo", nil, "fix(api): typo"},
+		{"faet:add endpoint\nbody", nil, "feat: add endpoint\n\nbody"},
+		{"fix:\tx", nil, "fix: x"},
+		{"fix:   Correct typo.\n\n\nbody\n\n\nBreaking-Change: y", []conventionalcommits.MachineOption{
+			WithStrictSpec(),
+			WithNoTrailingPeriod(),
+			WithDescriptionCase(conventionalcommits.CaseLowerFirst),
+		}, "fix: correct typo\n\nbody\n\nBREAKING-CHANGE: y"},
+		{"fix: x\n\nChange-Id: I1\nChange-Id: I2\nRefs: #1\nChange-Id: I3", []conventionalcommits.MachineOption{
+			WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersError),
+		}, "fix: x\n\nChange-Id: I1\nRefs: #1"},
+		{"fix: x\n\nbody\n\nFixes: #1\nRefs: #2\nAcked-by: Z", []conventionalcommits.MachineOption{
+			WithAllowedFooters([]string{"Refs"}),
+		}, "fix: x\n\nbody\n\nRefs: #2"},
+	}
+	for _, tc := range cases {
+		out, err := Fix([]byte(tc.input), tc.options...)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.output, string(out), tc.input)
+	}
+
+	out, err := Fix([]byte("fix: x"), WithRequiredScope())
+	assert.EqualError(t, err, fmt.Sprintf(ErrScopeRequired+ColumnPositionTemplate, ":", 3))
+	assert.Equal(t, "fix: x", string(out))
+
+	out, err = Fix([]byte("release typo"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "r", 0))
+	assert.Equal(t, "release typo", string(out))
+}
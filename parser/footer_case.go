@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// footerToken returns the token of the current footer trailer in the form the ordered footers have.
+func (m *machine) footerToken() string {
+	switch m.footerKeyCase {
+	case conventionalcommits.FooterKeyPreserve:
+		return string(m.data[m.footerTokenStart:m.footerSepStart])
+	case conventionalcommits.FooterKeyTrainCase:
+		if m.currentFooterKey == "breaking-change" {
+			return "BREAKING CHANGE"
+		}
+		words := strings.Split(m.currentFooterKey, "-")
+		for i, w := range words {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+		return strings.Join(words, "-")
+	default:
+		return m.currentFooterKey
+	}
+}
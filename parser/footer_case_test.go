@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFooterKeyCase(t *testing.T) {
+	input := "fix: x\n\nSigned-off-by: Leo\nsigned-off-by: Ana\nBREAKING-CHANGE: drop v1\nchange-ID: I1"
+	cases := []struct {
+		c      conventionalcommits.FooterKeyCase
+		tokens []string
+	}{
+		{conventionalcommits.FooterKeyLower, []string{"signed-off-by", "signed-off-by", "breaking-change", "change-id"}},
+		{conventionalcommits.FooterKeyPreserve, []string{"Signed-off-by", "signed-off-by", "BREAKING-CHANGE", "change-ID"}},
+		{conventionalcommits.FooterKeyTrainCase, []string{"Signed-Off-By", "Signed-Off-By", "BREAKING CHANGE", "Change-Id"}},
+	}
+	for _, tc := range cases {
+		msg, err := NewMachine(WithFooterKeyCase(tc.c)).Parse([]byte(input))
+		assert.Nil(t, err, tc.c.String())
+		c := msg.(*conventionalcommits.ConventionalCommit)
+
+		tokens := []string{}
+		for _, f := range c.OrderedFooters {
+			tokens = append(tokens, f.Token)
+		}
+		assert.Equal(t, tc.tokens, tokens, tc.c.String())
+		// The keys of the footers stay lowercase
+		assert.Equal(t, map[string][]string{"signed-off-by": {"Leo", "Ana"}, "breaking-change": {"drop v1"}, "change-id": {"I1"}}, c.Footers, tc.c.String())
+		assert.Equal(t, []string{"Leo", "Ana"}, c.Footer("Signed-off-by"), tc.c.String())
+		assert.True(t, c.IsBreakingChange(), tc.c.String())
+		assert.Equal(t, "fix: x\n\nSigned-off-by: Leo\nSigned-off-by: Ana\nBREAKING CHANGE: drop v1\nChange-id: I1", string(c.Normalize().Marshal()), tc.c.String())
+	}
+
+	msg, err := NewMachine(WithFooterKeyCase(conventionalcommits.FooterKeyPreserve)).Parse([]byte("fix: x\n\nReviewed-BY: Z\nBREAKING-CHANGE: drop v1"))
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+	assert.Equal(t, "fix: x\n\nReviewed-BY: Z\nBREAKING-CHANGE: drop v1", string(c.Marshal()))
+	assert.Equal(t, "Reviewed-BY #Z\nBREAKING-CHANGE: drop v1\n", c.GitTrailers(conventionalcommits.GitTrailerFormat{Separators: map[string]string{"reviewed-by": " #"}}))
+
+	// The dropped footer trailers drop their tokens too
+	msg, err = NewMachine(WithFooterKeyCase(conventionalcommits.FooterKeyPreserve), WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins)).Parse([]byte("fix: x\n\nChange-Id: I1\nRefs: #1\nchange-id: I2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []conventionalcommits.Footer{{Token: "Refs", Separator: ": ", Value: "#1"}, {Token: "change-id", Separator: ": ", Value: "I2"}}, msg.(*conventionalcommits.ConventionalCommit).OrderedFooters)
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortFooters(t *testing.T) {
+	i := []byte("fix: x\n\nbody\n\nSigned-off-by: A\nAcked-by: B\nRefs #1\nBREAKING CHANGE: drop v1\nSigned-off-by: C\nRefs #2")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+
+	out, err := conventionalcommits.SortFooters(i, res.SourceMap, conventionalcommits.DefaultFooterOrder)
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: x\n\nbody\n\nBREAKING CHANGE: drop v1\nRefs #1\nRefs #2\nAcked-by: B\nSigned-off-by: A\nSigned-off-by: C", string(out))
+
+	// The values are the same, in the new order
+	sorted, err := NewMachine().Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, res.Message.(*conventionalcommits.ConventionalCommit).Footers, sorted.(*conventionalcommits.ConventionalCommit).Footers)
+	assert.Equal(t, []conventionalcommits.Footer{
+		{Token: "breaking-change", Separator: ": ", Value: "drop v1"},
+		{Token: "refs", Separator: " #", Value: "1"},
+		{Token: "refs", Separator: " #", Value: "2"},
+		{Token: "acked-by", Separator: ": ", Value: "B"},
+		{Token: "signed-off-by", Separator: ": ", Value: "A"},
+		{Token: "signed-off-by", Separator: ": ", Value: "C"},
+	}, sorted.(*conventionalcommits.ConventionalCommit).OrderedFooters)
+
+	out, err = conventionalcommits.SortFooters(i, res.SourceMap, []string{"acked-by"})
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: x\n\nbody\n\nAcked-by: B\nSigned-off-by: A\nRefs #1\nBREAKING CHANGE: drop v1\nSigned-off-by: C\nRefs #2", string(out))
+
+	out, err = conventionalcommits.SortFooters([]byte("fix: x"), conventionalcommits.SourceMap{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: x", string(out))
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// ReportEntry is the outcome of parsing a single commit message file.
+type ReportEntry struct {
+	Message conventionalcommits.Message
+	Err     error
+}
+
+// Ok tells whether the commit message file is valid.
+func (e ReportEntry) Ok() bool {
+	return e.Err == nil
+}
+
+// Report maps the paths of commit message files to the outcome of parsing them.
+type Report map[string]ReportEntry
+
+// Ok tells whether all the commit message files in the report are valid.
+func (r Report) Ok() bool {
+	for _, e := range r {
+		if !e.Ok() {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the sorted paths of the invalid commit message files.
+func (r Report) Failed() []string {
+	paths := []string{}
+	for p, e := range r {
+		if !e.Ok() {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ParseFS parses every file of fsys matching the glob pattern as a commit message.
+//
+// The pattern syntax is the one of fs.Glob.
+// A single machine, configured with the given options, parses all the files.
+// It returns an error only when the pattern is malformed or a file can't be read.
+func ParseFS(fsys fs.FS, pattern string, options ...conventionalcommits.MachineOption) (Report, error) {
+	paths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	m := NewMachine(options...)
+	report := make(Report, len(paths))
+	for _, p := range paths {
+		input, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p, err)
+		}
+		msg, err := m.Parse(input)
+		report[p] = ReportEntry{Message: msg, Err: err}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"fixtures/ok.txt":     {Data: []byte("fix: x")},
+		"fixtures/no.txt":     {Data: []byte("fix x")},
+		"fixtures/docs.txt":   {Data: []byte("docs: x")},
+		"fixtures/ignore.msg": {Data: []byte("wrong")},
+	}
+
+	report, err := ParseFS(fsys, "fixtures/*.txt")
+	assert.Nil(t, err)
+	assert.Len(t, report, 3)
+	assert.False(t, report.Ok())
+	assert.Equal(t, []string{"fixtures/docs.txt", "fixtures/no.txt"}, report.Failed())
+	assert.True(t, report["fixtures/ok.txt"].Ok())
+	assert.EqualError(t, report["fixtures/no.txt"].Err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3))
+
+	report, err = ParseFS(fsys, "fixtures/*.txt", WithTypes(conventionalcommits.TypesConventional))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"fixtures/no.txt"}, report.Failed())
+}
+
+func TestParseFSMalformedPattern(t *testing.T) {
+	report, err := ParseFS(fstest.MapFS{}, "[")
+	assert.Nil(t, report)
+	assert.Error(t, err)
+}
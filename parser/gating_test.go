@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGates(t *testing.T) {
+	signed := classify(t, "fix: crash\n\nSigned-off-by: Leo <leo@example.com>")
+	security := classify(t, "fix: escape input\n\nSECURITY: CVE-2021-1234\nSigned-off-by: Leo <leo@example.com>")
+	breaking := classify(t, "feat!: drop v1")
+
+	assert.True(t, conventionalcommits.HasFooter("Security")(security))
+	assert.False(t, conventionalcommits.HasFooter("Security")(signed))
+	assert.True(t, conventionalcommits.HasFooter("BREAKING CHANGE")(classify(t, "feat: drop v1\n\nBREAKING CHANGE: v1 is gone")))
+
+	pr := []conventionalcommits.Message{signed, security}
+	assert.True(t, conventionalcommits.AllSignedOff(pr))
+	assert.False(t, conventionalcommits.AnyBreaking(pr))
+	assert.Equal(t, map[string]bool{"breaking": false, "signed-off": true, "security": true}, conventionalcommits.DefaultGates.Evaluate(pr))
+
+	pr = append(pr, breaking)
+	assert.False(t, conventionalcommits.AllSignedOff(pr))
+	assert.True(t, conventionalcommits.AnyBreaking(pr))
+
+	assert.False(t, conventionalcommits.AllSignedOff(nil))
+	assert.False(t, conventionalcommits.AnyBreaking(nil))
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitTrailers(t *testing.T) {
+	msg, err := NewMachine().Parse([]byte("feat: x\n\nReviewed-by: Z\nRefs #133\nBREAKING CHANGE: drop v1"))
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+
+	out := c.GitTrailers(conventionalcommits.GitTrailerFormat{})
+	assert.Equal(t, "Reviewed-by: Z\nRefs #133\nBREAKING-CHANGE: drop v1\n", out)
+
+	out = c.GitTrailers(conventionalcommits.GitTrailerFormat{Separators: map[string]string{"REFS": ": "}})
+	assert.Equal(t, "Reviewed-by: Z\nRefs: #133\nBREAKING-CHANGE: drop v1\n", out)
+
+	out = c.GitTrailers(conventionalcommits.GitTrailerFormat{Separator: " = "})
+	assert.Equal(t, "Reviewed-by = Z\nRefs #133\nBREAKING-CHANGE = drop v1\n", out)
+
+	out = c.GitTrailers(conventionalcommits.GitTrailerFormat{Separator: " = ", Separators: map[string]string{"refs": " = "}})
+	assert.Equal(t, "Reviewed-by = Z\nRefs = #133\nBREAKING-CHANGE = drop v1\n", out)
+
+	// The trailers parse back
+	again, err := NewMachine().Parse([]byte("feat: x\n\n" + c.GitTrailers(conventionalcommits.GitTrailerFormat{})))
+	assert.Nil(t, err)
+	assert.Equal(t, c.OrderedFooters, again.(*conventionalcommits.ConventionalCommit).OrderedFooters)
+
+	assert.Equal(t, "", (&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x"}).GitTrailers(conventionalcommits.GitTrailerFormat{}))
+}
@@ -0,0 +1,28 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestLabels(t *testing.T) {
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+	var messages []conventionalcommits.Message
+	for _, input := range []string{"feat(api): add endpoint", "fix: crash", "fix!: drop v1", "chore: bump"} {
+		m, err := p.Parse([]byte(input))
+		assert.Nil(t, err)
+		messages = append(messages, m)
+	}
+
+	assert.Equal(t, []string{"bug", "enhancement", "semver:major"}, conventionalcommits.DefaultLabelMapping.Suggest(messages))
+	assert.Equal(t, []string{"enhancement"}, conventionalcommits.DefaultLabelMapping.Labels(messages[0].(*conventionalcommits.ConventionalCommit)))
+	assert.Equal(t, []string{}, conventionalcommits.DefaultLabelMapping.Suggest(messages[3:]))
+
+	custom := conventionalcommits.LabelMapping{
+		{Match: conventionalcommits.ScopeIs("api"), Labels: []string{"area:api", "needs-review"}},
+		{Match: conventionalcommits.TypeIs("chore"), Labels: []string{"skip-changelog"}},
+	}
+	assert.Equal(t, []string{"area:api", "needs-review", "skip-changelog"}, custom.Suggest(messages))
+}
@@ -0,0 +1,254 @@
+package parser
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrMissingColonSpace warns the user that no space follows the colon before the description.
+	ErrMissingColonSpace = "missing white-space (' ') character after the colon"
+	// ErrTabSeparator warns the user that tab characters separate the colon from the description.
+	ErrTabSeparator = "tab character before the description"
+)
+
+// bom is the UTF-8 byte order mark.
+var bom = []byte{0xEF, 0xBB, 0xBF}
+
+// lenientEdit replaces the given number of bytes at the given position with the given text.
+type lenientEdit struct {
+	at     int
+	remove int
+	insert string
+	// shift is how many bytes the previous edits add, once applied
+	shift int
+}
+
+// lenientFix represents an amendment of the input that makes it conform to the grammar.
+//
+// Its edits are in the order of their positions, and do not overlap.
+// Without a warning, the amendment goes unnoticed.
+type lenientFix struct {
+	edits   []lenientEdit
+	warning string
+}
+
+// newLenientFix creates an amendment made of a single edit.
+func newLenientFix(at, remove int, insert, warning string) *lenientFix {
+	return &lenientFix{edits: []lenientEdit{{at: at, remove: remove, insert: insert}}, warning: warning}
+}
+
+// amend returns a copy of the given input with the edits applied.
+func (f *lenientFix) amend(input []byte) []byte {
+	amended := make([]byte, 0, len(input))
+	last := 0
+	for i := range f.edits {
+		e := &f.edits[i]
+		amended = append(amended, input[last:e.at]...)
+		e.shift = len(amended) - e.at
+		amended = append(amended, e.insert...)
+		last = e.at + e.remove
+	}
+	return append(amended, input[last:]...)
+}
+
+// unshift maps a position in the amended input back to the original input.
+func (f *lenientFix) unshift(pos int) int {
+	// The last edit starting before the position, or at it when the edit only removes bytes
+	i := sort.Search(len(f.edits), func(i int) bool {
+		start := f.edits[i].at + f.edits[i].shift
+		return start > pos || start == pos && len(f.edits[i].insert) > 0
+	}) - 1
+	if i < 0 {
+		return pos
+	}
+	e := f.edits[i]
+	if pos >= e.at+e.shift+len(e.insert) {
+		return pos - e.shift - len(e.insert) + e.remove
+	}
+	return e.at
+}
+
+// lenientFix returns the amendment of the input that the lenient options allow for the error of a failed parsing, if any.
+func (m *machine) lenientFix(failed bool) *lenientFix {
+	p, ok := m.err.(positioned)
+	if !failed || !ok {
+		return nil
+	}
+	e := p.base()
+	at := e.span.Start
+
+	switch {
+	case m.lenientColonSpace && e.template == ErrDescriptionInit && at > 0 && m.data[at-1] == ':' && m.data[at] != '\n':
+		return newLenientFix(at, 0, " ", ErrMissingColonSpace)
+	case m.descriptionSeparator != conventionalcommits.SeparatorSpaces && e.template == ErrDescriptionInit && at > 0 && m.data[at-1] == ':' && m.data[at] == '\t':
+		end := at + 1
+		if m.descriptionSeparator == conventionalcommits.SeparatorWhitespace {
+			for end < m.pe && (m.data[end] == ' ' || m.data[end] == '\t') {
+				end++
+			}
+		}
+		return newLenientFix(at, end-at, " ", ErrTabSeparator)
+	case m.lenientBlankLine && e.template == ErrMissingBlankLineAtBeginning && at > 0 && m.data[at-1] == '\n':
+		return newLenientFix(at, 0, "\n", ErrMissingBlankLineAtBeginning)
+	case m.multilineFooters && (e.template == ErrTrailer || e.template == ErrTrailerIncomplete) && m.currentFooterKey != "":
+		return m.foldFooters(at)
+	default:
+		return nil
+	}
+}
+
+// trailerStartRegexp matches the lines starting a footer trailer.
+var trailerStartRegexp = regexp.MustCompile(`^(?:[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*(?::| #)|BREAKING[ -]CHANGE:)`)
+
+// foldFooters returns the amendment joining the continuation line at the given position,
+// and the ones after it, to the footer trailer values they continue, if any.
+//
+// It leaves the blank lines, and the lines starting another trailer, alone,
+// and it stops at the first line that follows a blank line without starting a trailer.
+// It amends the rest of the input in a single pass, so that the parser reparses it only once.
+func (m *machine) foldFooters(at int) *lenientFix {
+	data := m.data[:m.pe]
+	nl := bytes.LastIndexByte(data[:at], '\n')
+	if nl < 1 || data[nl-1] == '\n' {
+		return nil
+	}
+
+	fix := &lenientFix{}
+	for blank := false; nl < len(data); {
+		end := len(data)
+		if i := bytes.IndexByte(data[nl+1:], '\n'); i >= 0 {
+			end = nl + 1 + i
+		}
+		start := nl + 1
+		for start < end && (data[start] == ' ' || data[start] == '\t') {
+			start++
+		}
+
+		switch {
+		case start == end:
+			blank = true
+		case trailerStartRegexp.Match(data[nl+1 : end]):
+			blank = false
+		case blank:
+			end = len(data)
+		default:
+			fix.edits = append(fix.edits, lenientEdit{at: nl, remove: start - nl, insert: " "})
+		}
+		if len(fix.edits) == 0 {
+			// The line at the given position is not a continuation line
+			return nil
+		}
+		nl = end
+	}
+
+	return fix
+}
+
+// trimDescriptionSeparator removes the tab characters following the spaces before the description.
+//
+// It warns about them.
+func (m *machine) trimDescriptionSeparator(output *conventionalCommit) {
+	trimmed := strings.TrimLeft(output.descr, " \t")
+	if len(trimmed) == len(output.descr) {
+		return
+	}
+	// The description ends the header
+	end := bytes.IndexByte(m.data, '\n')
+	if end < 0 {
+		end = m.pe
+	}
+	at := end - len(output.descr)
+	m.warnings = append(m.warnings, m.emitErrorAt(conventionalcommits.Span{Start: at, End: at}, ErrTabSeparator, at))
+	output.descr = trimmed
+}
+
+// skipBOM returns the amendment removing the byte order mark at the beginning of the input, if any.
+func (m *machine) skipBOM() *lenientFix {
+	if !m.skipByteOrderMark || !bytes.HasPrefix(m.data, bom) {
+		return nil
+	}
+	return newLenientFix(0, len(bom), "", "")
+}
+
+// reparse parses the input amended with the given fix, then maps the positions in the outcome back to the input.
+//
+// It records the warning about the amendment, if any.
+func (m *machine) reparse(input []byte, fix *lenientFix) (conventionalcommits.Message, error) {
+	amended := fix.amend(input)
+
+	if m.headerLimit > 0 {
+		// The original input already passed the header check
+		inserted := 0
+		for _, e := range fix.edits {
+			inserted += len(e.insert)
+		}
+		m.headerLimit += inserted
+		defer func() {
+			m.headerLimit -= inserted
+		}()
+	}
+	if m.maxHeaderLength > 0 {
+		// The header length counts the characters of the original first line, which the byte order mark is not part of
+		nl := bytes.IndexByte(input, '\n')
+		delta := 0
+		for _, e := range fix.edits {
+			if e.at > 0 && (nl < 0 || e.at <= nl) {
+				delta += utf8.RuneCountInString(e.insert) - utf8.RuneCount(input[e.at:e.at+e.remove])
+			}
+		}
+		m.headerShift += delta
+		defer func() {
+			m.headerShift -= delta
+		}()
+	}
+	footers := 0
+	if m.sourceMap != nil {
+		footers = len(m.sourceMap.Footers)
+	}
+
+	res, err := m.Parse(amended)
+	m.data, m.pe, m.eof = input, len(input), len(input)
+
+	m.unshiftError(err, fix, input)
+	for _, w := range m.warnings {
+		m.unshiftError(w, fix, input)
+	}
+	if m.sourceMap != nil {
+		for _, s := range []*conventionalcommits.Span{&m.sourceMap.Type, &m.sourceMap.Scope} {
+			s.Start, s.End = fix.unshift(s.Start), fix.unshift(s.End)
+		}
+		for i := footers; i < len(m.sourceMap.Footers); i++ {
+			f := &m.sourceMap.Footers[i]
+			for _, s := range []*conventionalcommits.Span{&f.Span, &f.TokenSpan, &f.ValueSpan} {
+				s.Start, s.End = fix.unshift(s.Start), fix.unshift(s.End)
+			}
+		}
+	}
+	if fix.warning != "" {
+		at := fix.edits[0].at
+		m.warnings = append(m.warnings, m.emitErrorAt(conventionalcommits.Span{Start: at, End: at}, fix.warning, at))
+	}
+
+	return res, err
+}
+
+// unshiftError maps the positions of the given error back to the original input.
+func (m *machine) unshiftError(err error, fix *lenientFix, input []byte) {
+	switch e := err.(type) {
+	case positioned:
+		p := e.base()
+		p.span.Start, p.span.End = fix.unshift(p.span.Start), fix.unshift(p.span.End)
+		p.args[len(p.args)-1] = fix.unshift(p.Column())
+		p.position = m.position(input, p.Column())
+		p.suggestion = m.suggest(input, p.template, p.args)
+	case *RewindBudgetError:
+		e.Column = fix.unshift(e.Column)
+		e.position = m.position(input, e.Column)
+	}
+}
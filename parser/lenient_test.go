@@ -0,0 +1,274 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLenientColonSpace(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"missing-space",
+			"fix:typo in parser",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "typo in parser"},
+		},
+		{
+			"missing-space-after-scope",
+			"fix(parser)!:typo\n\nbody\n\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("parser"), Exclamation: true, Description: "typo", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+		{
+			"later-error",
+			"fix:typo\nbody",
+			fmt.Sprintf(ErrMissingBlankLineAtBeginning+ColumnPositionTemplate, 9),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "typo"},
+		},
+		{
+			"missing-description",
+			"fix:\n",
+			fmt.Sprintf(ErrDescriptionInit+ColumnPositionTemplate, "\n", 4),
+			nil,
+		},
+	}, WithLenientColonSpace())
+
+	res := newMachine(WithLenientColonSpace()).ParseResult([]byte("fix:typo\n\nRefs: #1"))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrMissingColonSpace+ColumnPositionTemplate, 4))
+	assert.Equal(t, conventionalcommits.Span{Start: 10, End: 18}, res.SourceMap.Footers[0].Span)
+	assert.Equal(t, "missing-colon-space", res.Diagnostics()[0].Code)
+
+	// It stays opt-in
+	_, err := NewMachine().Parse([]byte("fix:typo"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrDescriptionInit+ColumnPositionTemplate, "t", 4))
+}
+
+func TestLenientBlankLine(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"body-right-after-description",
+			"fix: typo\nbody\nmore body",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "typo", Body: cctesting.StringAddress("body\nmore body")},
+		},
+		{
+			"footer-right-after-description",
+			"fix: typo\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "typo", Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+		{
+			"later-error",
+			"fix: typo\nbody\n\nRefs: #1\nwrong",
+			fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "g", 30),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "typo", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+	}, WithLenientBlankLine())
+
+	res := newMachine(WithLenientBlankLine(), WithLenientColonSpace()).ParseResult([]byte("fix:typo\nRefs: #1"))
+	assert.True(t, res.Ok())
+	assert.Equal(t, "typo", res.Message.(*conventionalcommits.ConventionalCommit).Description)
+	assert.Len(t, res.Warnings, 2)
+	assert.Equal(t, conventionalcommits.Span{Start: 9, End: 17}, res.SourceMap.Footers[0].Span)
+	assert.Equal(t, conventionalcommits.Span{Start: 4, End: 8}, res.SourceMap.Description)
+}
+
+func TestTrimTrailingWhitespace(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"git-log-output",
+			"fix: x\n",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x"},
+		},
+		{
+			"description",
+			"fix: x  \n\n\n",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x"},
+		},
+		{
+			"body",
+			"fix: x \n\nbody  \n\n\nRefs: 1  \nAcked-by: A \t\n  \n",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"refs": {"1"}, "acked-by": {"A"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "1"}, {Token: "acked-by", Separator: ": ", Value: "A"}}},
+		},
+		{
+			"inner-whitespace",
+			"fix: x  y\n\nbody  \nmore\n",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x  y", Body: cctesting.StringAddress("body  \nmore")},
+		},
+	}, WithTrimTrailingWhitespace())
+
+	i := []byte("fix: x  \n\nRefs: 1  \nAcked-by: A\n")
+	res := newMachine(WithTrimTrailingWhitespace()).ParseResult(i)
+	assert.True(t, res.Ok())
+	assert.Equal(t, "x", string(res.SourceMap.Description.Text(i)))
+	assert.Equal(t, "Refs: 1", string(res.SourceMap.Footers[0].Span.Text(i)))
+	assert.Equal(t, "1", string(res.SourceMap.Footers[0].ValueSpan.Text(i)))
+
+	_, err := NewMachine().Parse([]byte("fix: x\n"))
+	assert.Error(t, err)
+}
+
+func TestSkipBOM(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"bom",
+			"\xEF\xBB\xBFfix: x\n\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x", Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+		{
+			"no-bom",
+			"fix: x",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x"},
+		},
+		{
+			"later-error",
+			"\xEF\xBB\xBFfix x",
+			fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 6),
+			nil,
+		},
+	}, WithSkipBOM())
+
+	i := []byte("\xEF\xBB\xBFfix: x\n\nRefs: #1")
+	res := newMachine(WithSkipBOM()).ParseResult(i)
+	assert.Empty(t, res.Warnings)
+	assert.Equal(t, "x", string(res.SourceMap.Description.Text(i)))
+	assert.Equal(t, "Refs: #1", string(res.SourceMap.Footers[0].Span.Text(i)))
+
+	_, err := NewMachine().Parse(i)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "ï", 0))
+}
+
+func TestDescriptionSeparator(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"tab",
+			"fix:\tmessage",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"tabs",
+			"fix:\t\tmessage",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "\tmessage"},
+		},
+		{
+			"spaces",
+			"fix:  message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+	}, WithDescriptionSeparator(conventionalcommits.SeparatorSpacesOrTab))
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"tab",
+			"fix:\tmessage",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"tab-and-spaces",
+			"fix(api):\t  \tmessage\n\nbody",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api"), Description: "message", Body: cctesting.StringAddress("body")},
+		},
+		{
+			"spaces-and-tab",
+			"fix: \tmessage",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"only-whitespace",
+			"fix:\t \n",
+			fmt.Sprintf(ErrNewline+ColumnPositionTemplate, 7),
+			nil,
+		},
+	}, WithDescriptionSeparator(conventionalcommits.SeparatorWhitespace))
+
+	res := newMachine(WithDescriptionSeparator(conventionalcommits.SeparatorWhitespace)).ParseResult([]byte("fix: \tmessage"))
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrTabSeparator+ColumnPositionTemplate, 5))
+	assert.Equal(t, conventionalcommits.Span{Start: 6, End: 13}, res.SourceMap.Description)
+
+	res = newMachine(WithDescriptionSeparator(conventionalcommits.SeparatorWhitespace)).ParseResult([]byte("fix:\tmessage"))
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrTabSeparator+ColumnPositionTemplate, 4))
+
+	_, err := NewMachine().Parse([]byte("fix:\tmessage"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrDescriptionInit+ColumnPositionTemplate, "\t", 4))
+}
+
+func TestMultilineFooters(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"continuation",
+			"feat: x\n\nBREAKING CHANGE: drop the v1 API\nin favor of v2\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "feat",
+				Description:    "x",
+				Footers:        map[string][]string{"breaking-change": {"drop the v1 API in favor of v2"}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "breaking-change", Separator: ": ", Value: "drop the v1 API in favor of v2"}, {Token: "refs", Separator: ": ", Value: "#1"}},
+			},
+		},
+		{
+			"indented",
+			"fix: x\n\nRefs: #1\n  #2\n\t#3",
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"refs": {"#1 #2 #3"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1 #2 #3"}},
+			},
+		},
+		{
+			"blank-line",
+			"fix: x\n\nRefs: #1\n\nmore",
+			fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "e", 22),
+			nil,
+		},
+		{
+			"invalid-trailer",
+			"fix: x\n\nRefs: #1\nAcked-by:",
+			fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, ":", 26),
+			nil,
+		},
+	}, WithTypes(conventionalcommits.TypesConventional), WithMultilineFooters())
+
+	i := []byte("fix: x\n\nRefs: #1\n  #2\nAcked-by: me")
+	res := newMachine(WithMultilineFooters()).ParseResult(i)
+	assert.True(t, res.Ok())
+	assert.Empty(t, res.Warnings)
+	assert.Equal(t, "Refs: #1\n  #2", string(res.SourceMap.Footers[0].Span.Text(i)))
+	assert.Equal(t, "Acked-by: me", string(res.SourceMap.Footers[1].Span.Text(i)))
+
+	_, err := NewMachine().Parse(i)
+	assert.Error(t, err)
+
+	// The parser folds all the continuation lines at once, rather than reparsing the input for each one of them
+	lines := strings.Repeat("\nmore", 5000)
+	i = []byte("fix: x\n\nRefs: #1" + lines + "\nAcked-by: me" + lines)
+	m := NewMachine(WithMultilineFooters()).(*machine)
+	m.data, m.pe = i, len(i)
+	fix := m.foldFooters(len("fix: x\n\nRefs: #1\n"))
+	assert.Len(t, fix.edits, 10000)
+	msg, err := m.Parse(i)
+	assert.Nil(t, err)
+	assert.Equal(t, "#1"+strings.Repeat(" more", 5000), msg.(*conventionalcommits.ConventionalCommit).Footers["refs"][0])
+	assert.Equal(t, "me"+strings.Repeat(" more", 5000), msg.(*conventionalcommits.ConventionalCommit).Footers["acked-by"][0])
+}
@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrHeaderTooLong tells the user that the header of the commit message exceeds the limit.
+	ErrHeaderTooLong = "header longer than %d bytes"
+	// ErrRewindBudget tells the user that the parser backtracked too much while looking for footer trailers.
+	ErrRewindBudget = "backtracking exceeded the budget of %d bytes"
+)
+
+// HeaderTooLongError is the error returned when the header of the input exceeds the limit set with WithHeaderLimit.
+type HeaderTooLongError struct {
+	Limit     int
+	position  conventionalcommits.Position
+	formatter conventionalcommits.ErrorFormatter
+}
+
+// Error returns the message of the error, reporting the limit as the column where the error occurs,
+// unless a custom formatter renders it.
+func (e *HeaderTooLongError) Error() string {
+	if e.formatter != nil {
+		return format(e.formatter, e.Diagnostic(), e.position, ErrHeaderTooLong, e.Limit)
+	}
+	return fmt.Sprintf(ErrHeaderTooLong+ColumnPositionTemplate, e.Limit, e.Limit)
+}
+
+// checkHeaderLimit returns an error when the header of the input is longer than the limit.
+//
+// It looks at most at the limit plus one bytes of the input.
+func (m *machine) checkHeaderLimit() error {
+	if m.pe <= m.headerLimit || bytes.IndexByte(m.data[:m.headerLimit+1], '\n') >= 0 {
+		return nil
+	}
+
+	e := &HeaderTooLongError{Limit: m.headerLimit, position: m.position(m.data, m.headerLimit), formatter: m.errorFormatter}
+	if m.logger != nil {
+		m.logger.Errorln(e)
+	}
+	return e
+}
+
+// RewindBudgetError is the error returned when the parser exceeds its rewind budget (see WithRewindBudget).
+type RewindBudgetError struct {
+	Budget    int
+	Column    int
+	position  conventionalcommits.Position
+	formatter conventionalcommits.ErrorFormatter
+}
+
+// Error returns the message of the error, unless a custom formatter renders it.
+func (e *RewindBudgetError) Error() string {
+	if e.formatter != nil {
+		return format(e.formatter, e.Diagnostic(), e.position, ErrRewindBudget, e.Budget)
+	}
+	return fmt.Sprintf(ErrRewindBudget+ColumnPositionTemplate, e.Budget, e.Column)
+}
+
+// spendRewindBudget accounts for the backtracking to the current marker.
+//
+// It returns an error when the total backtracking exceeds the budget.
+func (m *machine) spendRewindBudget() error {
+	budget := m.rewindBudget
+	if budget <= 0 {
+		budget = m.pe
+	}
+	m.rewound += m.p - m.pb
+	if m.rewound <= budget {
+		return nil
+	}
+
+	e := &RewindBudgetError{Budget: budget, Column: m.p, position: m.position(m.data, m.p), formatter: m.errorFormatter}
+	if m.logger != nil {
+		m.logger.Errorln(e)
+	}
+	return e
+}
+
+// Diagnostic converts the error into a diagnostic.
+func (e *HeaderTooLongError) Diagnostic() conventionalcommits.Diagnostic {
+	return conventionalcommits.Diagnostic{
+		Code:     codes[ErrHeaderTooLong],
+		Severity: conventionalcommits.SeverityError,
+		Range:    conventionalcommits.Span{Start: e.Limit, End: e.Limit},
+		Message:  fmt.Sprintf(ErrHeaderTooLong, e.Limit),
+	}
+}
+
+// Diagnostic converts the error into a diagnostic.
+func (e *RewindBudgetError) Diagnostic() conventionalcommits.Diagnostic {
+	return conventionalcommits.Diagnostic{
+		Code:     codes[ErrRewindBudget],
+		Severity: conventionalcommits.SeverityError,
+		Range:    conventionalcommits.Span{Start: e.Column, End: e.Column},
+		Message:  fmt.Sprintf(ErrRewindBudget, e.Budget),
+	}
+}
+
+// Position returns the line and the column where the error occurs.
+func (e *HeaderTooLongError) Position() conventionalcommits.Position {
+	return e.position
+}
+
+// Position returns the line and the column where the error occurs.
+func (e *RewindBudgetError) Position() conventionalcommits.Position {
+	return e.position
+}
@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderLimit(t *testing.T) {
+	p := NewMachine(WithHeaderLimit(10), WithBestEffort())
+
+	res, err := p.Parse([]byte("fix: 12345"))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	res, err = p.Parse([]byte("fix: 12345\n\nbody longer than the header limit"))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	res, err = p.Parse([]byte("fix: 123456"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrHeaderTooLong+ColumnPositionTemplate, 10, 10))
+	var tooLong *HeaderTooLongError
+	assert.True(t, errors.As(err, &tooLong))
+	assert.Equal(t, 10, tooLong.Limit)
+
+	res, err = p.Parse([]byte("fix: " + strings.Repeat("a", 1<<20)))
+	assert.Nil(t, res)
+	assert.True(t, errors.As(err, &tooLong))
+}
+
+func TestHeaderLimitWithBOM(t *testing.T) {
+	p := NewMachine(WithHeaderLimit(10), WithSkipBOM())
+
+	res, err := p.Parse([]byte("\xEF\xBB\xBFfix: 12"))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	// The limit counts the byte order mark
+	res, err = p.Parse([]byte("\xEF\xBB\xBFfix: 123"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrHeaderTooLong+ColumnPositionTemplate, 10, 10))
+}
+
+func TestLongInputs(t *testing.T) {
+	long := strings.Repeat("a", 1<<20)
+
+	res, err := NewMachine().Parse([]byte("fix: x\n\n" + long))
+	assert.Nil(t, err)
+	assert.Equal(t, long, *res.(*conventionalcommits.ConventionalCommit).Body)
+
+	res, err = NewMachine(WithTypes(conventionalcommits.TypesFreeForm)).Parse([]byte(long + ": x"))
+	assert.Nil(t, err)
+	assert.Equal(t, long, res.(*conventionalcommits.ConventionalCommit).Type)
+}
+
+func TestRewindBudget(t *testing.T) {
+	i := []byte("fix: x\n\naaaa-bbbb\n\naaaa-bbbb\n\naaaa-bbbb")
+
+	res, err := NewMachine().Parse(i)
+	assert.Nil(t, err)
+	assert.Equal(t, "aaaa-bbbb\n\naaaa-bbbb\n\naaaa-bbbb", *res.(*conventionalcommits.ConventionalCommit).Body)
+
+	res, err = NewMachine(WithRewindBudget(15), WithBestEffort()).Parse(i)
+	assert.EqualError(t, err, fmt.Sprintf(ErrRewindBudget+ColumnPositionTemplate, 15, 28))
+	var budgetErr *RewindBudgetError
+	assert.True(t, errors.As(err, &budgetErr))
+	assert.Equal(t, 15, budgetErr.Budget)
+	assert.Equal(t, "aaaa-bbbb", *res.(*conventionalcommits.ConventionalCommit).Body)
+}
+
+func TestRewindIsLinear(t *testing.T) {
+	adversarial := map[string]string{
+		"tokens":               strings.Repeat("aaaa-bbbb\n\n", 10000),
+		"tokens-without-blank": strings.Repeat("aaaa-bbbb\n", 10000),
+		"long-token":           strings.Repeat("a-", 50000) + "\n\nx",
+		"incomplete-separator": strings.Repeat("a\n\na ", 10000),
+		"breaking-change":      strings.Repeat("BREAKING CHANGE\n\n", 10000),
+	}
+
+	for name, body := range adversarial {
+		i := []byte("fix: x\n\n" + body)
+		m := NewMachine().(*machine)
+		_, err := m.Parse(i)
+		assert.Nil(t, err, name)
+		// Every byte is backtracked over at most once
+		assert.LessOrEqual(t, m.rewound, len(i), name)
+	}
+}
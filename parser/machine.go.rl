@@ -1,8 +1,8 @@
 package parser
 
 import (
-	"fmt"
 	"bytes"
+	"regexp"
 
 	"github.com/reviewpad/go-conventionalcommits"
 	"github.com/sirupsen/logrus"
@@ -114,12 +114,16 @@ action err_begin_blank_line {
 # Setters
 
 action set_type {
-	output._type = string(m.text())
-	m.emitInfo("valid commit message type", "type", output._type)
+	output._type = m.text()
+	m.mapType()
+	if m.logger != nil {
+		m.emitInfo("valid commit message type", "type", string(output._type))
+	}
 }
 
 action set_scope {
 	output.scope = string(m.text())
+	m.mapScope()
 	m.emitInfo("valid commit message scope", "scope", output.scope)
 }
 
@@ -144,11 +148,17 @@ action set_current_footer_key {
 		m.currentFooterKey = "breaking-change"
 	}
 	m.emitDebug("possibly valid footer token", "token", m.currentFooterKey, "pos", m.p)
+	m.mapFooterToken()
 }
 
 action set_footer {
-	output.footers[m.currentFooterKey] = append(output.footers[m.currentFooterKey], string(m.text()))
+	output.addFooter(m.currentFooterKey, m.footerToken(), m.footerSeparator(), string(m.text()))
 	m.emitInfo("valid commit message footer trailer", m.currentFooterKey, string(m.text()))
+	m.mapFooter()
+	m.checkFooterToken()
+	m.checkDuplicateFooter(output)
+	m.recordChangeID()
+	m.checkAllowedFooter()
 }
 
 action count_nl {
@@ -161,21 +171,25 @@ action count_nl {
 action append_body {
 	// Append newlines
 	for ; m.countNewlines > 0; {
-		output.body += "\n"
+		output.body = append(output.body, '\n')
 		m.countNewlines--
 		m.emitInfo("valid commit message body content", "body", "\n")
 	}
 	// Append body content
-	output.body += string(m.text())
-	m.emitInfo("valid commit message body content", "body", string(m.text()))
+	output.body = append(output.body, m.text()...)
+	if m.logger != nil {
+		m.emitInfo("valid commit message body content", "body", string(m.text()))
+	}
 }
 
 action append_body_before_blank_line {
 	// Append content to body
 	m.pb++
 	m.p++
-	output.body += string(m.text())
-	m.emitInfo("valid commit message body content", "body", string(m.text()))
+	output.body = append(output.body, m.text()...)
+	if m.logger != nil {
+		m.emitInfo("valid commit message body content", "body", string(m.text()))
+	}
 	// Do not advance over the current char
 	fhold;
 }
@@ -202,9 +216,13 @@ action rewind {
 			// (they be added in the result by the body content appender)
 			m.pb = m.lastNewline + 1
 		}
-		fexec m.pb;
-		m.emitDebug("try to parse body content", "pos", m.p)
-		fgoto body;
+		if err := m.spendRewindBudget(); err != nil {
+			m.err = err
+		} else {
+			fexec m.pb;
+			m.emitDebug("try to parse body content", "pos", m.p)
+			fgoto body;
+		}
 	} else {
 		// A rewind happens when an error while parsing a footer trailer is encountered
 		// If this is not the first footer trailer the parser can't go back to parse body content again
@@ -294,17 +312,29 @@ free_form_types_main := free_form_types >eof(err_empty) >mark @err(err_type) %fr
 %% write data noerror noprefix;
 
 type machine struct {
+	ParserConfig
+	typesChosen      bool
 	data             []byte
 	cs               int
 	p, pe, eof       int
 	pb               int
 	err              error
-	bestEffort       bool
-	typeConfig       conventionalcommits.TypeConfig
-	logger           *logrus.Logger
 	currentFooterKey string
+	footerTokenStart int
+	footerSepStart   int
+	sourceMap        *conventionalcommits.SourceMap
 	countNewlines    int
 	lastNewline      int
+	rewound          int
+	warnings         []error
+	firstFooterStart int
+	footerViolation  error
+	headerShift      int
+	duplicateFooter  error
+	changeIDs        []conventionalcommits.Span
+	disallowedFooter error
+	lines            *conventionalcommits.SourceMap
+	linesInput       []byte
 }
 
 func (m *machine) text() []byte {
@@ -331,8 +361,15 @@ func (m *machine) emitDebug(s string, args... interface{}) {
 	}
 }
 
+// emitError emits an error whose last argument is the column where it occurs.
 func (m *machine) emitError(s string, args... interface{}) error {
-	e := fmt.Errorf(s + ColumnPositionTemplate, args...)
+	col := args[len(args) - 1].(int)
+	return m.emitErrorAt(conventionalcommits.Span{Start: col, End: col}, s, args...)
+}
+
+// emitErrorAt emits an error about the given span of the input.
+func (m *machine) emitErrorAt(span conventionalcommits.Span, s string, args... interface{}) error {
+	e := m.newParseError(m.data, span, s, args)
 	if m.logger != nil {
 		m.logger.Errorln(e)
 	}
@@ -340,19 +377,41 @@ func (m *machine) emitError(s string, args... interface{}) error {
 }
 
 func (m *machine) emitErrorWithoutCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, m.p)
+	return m.emitErrorAt(conventionalcommits.Span{Start: m.p, End: m.p}, messageTemplate, m.p)
 }
 
 func (m *machine) emitErrorOnCurrentCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p]), m.p)
+	return m.emitErrorAt(conventionalcommits.Span{Start: m.p, End: m.p + 1}, messageTemplate, string(m.data[m.p]), m.p)
 }
 
 func (m *machine) emitErrorOnPreviousCharacter(messageTemplate string) error {
-	return m.emitError(messageTemplate, string(m.data[m.p - 1]), m.p)
+	return m.emitErrorAt(conventionalcommits.Span{Start: m.p - 1, End: m.p}, messageTemplate, string(m.data[m.p - 1]), m.p)
+}
+
+// Machine is a FSM able to parse Conventional Commits, configurable with all the options of this package.
+type Machine interface {
+	conventionalcommits.Machine
+	conventionalcommits.ResultParser
+	conventionalcommits.TypeMatcher
+	conventionalcommits.HeaderLimiter
+	conventionalcommits.RewindLimiter
+	conventionalcommits.RuleEnforcer
+	conventionalcommits.ScopeConfigurer
+	conventionalcommits.FooterConfigurer
+	conventionalcommits.LenientConfigurer
+	conventionalcommits.ErrorFormatterSetter
+	conventionalcommits.PostprocessorSetter
 }
 
 // NewMachine creates a new FSM able to parse Conventional Commits.
+//
+// The machine it returns is a Machine too, configurable with all the options of this package.
 func NewMachine(options ...conventionalcommits.MachineOption) conventionalcommits.Machine {
+	return newMachine(options...)
+}
+
+// newMachine creates a new FSM able to parse Conventional Commits, configured with the given options.
+func newMachine(options ...conventionalcommits.MachineOption) *machine {
 	m := &machine{}
 
 	for _, opt := range options {
@@ -370,7 +429,8 @@ func NewMachine(options ...conventionalcommits.MachineOption) conventionalcommit
 
 // Parse parses the input byte array as a Conventional Commit message with no body neither footer.
 //
-// When a valid Conventional Commit message is given it outputs its structured representation.
+// When a valid Conventional Commit message is given it outputs its structured representation,
+// a *conventionalcommits.ConventionalCommit. Use ParseResult to also get the positions of its parts.
 // If the parsing detects an error it returns it with the position where the error occurred.
 //
 // It can also partially parse input messages returning a partially valid structured representation
@@ -384,10 +444,58 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 	m.err = nil
 	m.currentFooterKey = ""
 	m.countNewlines = 0
+	m.rewound = 0
+	m.warnings = nil
+	m.firstFooterStart = -1
+	m.footerViolation = nil
+	m.duplicateFooter = nil
+	m.changeIDs = nil
+	m.disallowedFooter = nil
+	if m.sourceMap == nil {
+		// Otherwise, ParseResult set the source map of the input as its lines
+		m.lines = nil
+	}
+	mapped := m.mappedFooters()
 	output := &conventionalCommit{}
 	output.footers = make(map[string][]string)
 
-	switch m.typeConfig {
+	if m.trimTrailingWhitespace {
+		m.trimInput()
+	}
+
+	if err := m.checkText(); err != nil {
+		return nil, err
+	}
+
+	if m.headerLimit > 0 {
+		if err := m.checkHeaderLimit(); err != nil {
+			return nil, err
+		}
+	}
+
+	// The header limit counts the byte order mark, so that the amended input passes the header check too
+	if fix := m.skipBOM(); fix != nil {
+		return m.reparse(input, fix)
+	}
+
+	typeConfig := m.typeConfig
+	registry := m.registry()
+	switch {
+	case m.typePattern != nil:
+		// Check the type against the pattern in advance, then let the free-form types machine parse the rest
+		if err := m.checkTypePattern(); err != nil {
+			return nil, err
+		}
+		typeConfig = conventionalcommits.TypesFreeForm
+	case registry != nil:
+		// Check the type against the registry in advance, then let the free-form types machine parse the rest
+		if err := m.checkType(registry); err != nil {
+			return nil, err
+		}
+		typeConfig = conventionalcommits.TypesFreeForm
+	}
+
+	switch typeConfig {
 	case conventionalcommits.TypesFreeForm:
 		m.cs = en_free_form_types_main
 		break
@@ -402,18 +510,47 @@ func (m *machine) Parse(input []byte) (conventionalcommits.Message, error) {
 	}
 	%% write exec;
 
-	if m.cs < first_final {
+	failed := m.cs < first_final
+	if fix := m.lenientFix(failed); fix != nil {
+		// Parse again the input amended so that it conforms to the grammar
+		m.unmapFooters(mapped)
+		return m.reparse(input, fix)
+	}
+	if failed && m.err == nil && m.cs == en_trailer_end {
+		// The input ends after a footer trailer token and its separator, without the trailer value
+		m.err = m.emitErrorOnPreviousCharacter(ErrTrailerIncomplete)
+	}
+	if err := m.checkRules(output); err != nil {
+		// Rules only apply to the header, thus their errors come before the ones of the machine
+		m.err = err
+		failed = true
+	}
+	if m.descriptionSeparator == conventionalcommits.SeparatorWhitespace && output.descr != "" {
+		m.trimDescriptionSeparator(output)
+	}
+	if m.trimTrailingWhitespace {
+		m.trimOutput(output)
+	}
+
+	if m.typePattern == nil && registry != nil {
+		output.canonicalizeType(registry)
+	}
+
+	if failed {
 		if m.bestEffort && output.minimal() {
 			// An error occurred but partial parsing is on and partial message is minimally valid
-			return output.export(), m.err
+			return m.postprocess(output.export(), m.err)
 		}
 		return nil, m.err
 	}
 
-	return output.export(), nil
+	return m.postprocess(output.export(), nil)
 }
 
 // WithBestEffort enables best effort mode.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithBestEffort option to NewParser instead.
 func (m *machine) WithBestEffort() {
 	m.bestEffort = true
 }
@@ -424,11 +561,245 @@ func (m *machine) HasBestEffort() bool {
 }
 
 // WithTypes tells the parser which commit message types to consider.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithTypes option to NewParser instead.
 func (m *machine) WithTypes(t conventionalcommits.TypeConfig) {
 	m.typeConfig = t
+	m.typesChosen = true
+}
+
+// WithTypeRegistry tells the parser which registry to check the commit message types against.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithTypeRegistry option to NewParser instead.
+func (m *machine) WithTypeRegistry(r *conventionalcommits.TypeRegistry) {
+	m.typeRegistry = r
+	m.typesChosen = true
+}
+
+// WithTypePattern tells the parser which regular expression the commit message types must match.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithTypePattern option to NewParser instead.
+func (m *machine) WithTypePattern(re *regexp.Regexp) {
+	m.typePattern = re
+	m.typesChosen = true
+}
+
+// WithCaseInsensitiveTypes tells the parser to match the types in the type registry regardless of their case.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithCaseInsensitiveTypes option to NewParser instead.
+func (m *machine) WithCaseInsensitiveTypes() {
+	m.caseInsensitiveTypes = true
+}
+
+// WithHeaderLimit tells the parser to reject inputs whose header is longer than n bytes.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithHeaderLimit option to NewParser instead.
+func (m *machine) WithHeaderLimit(n int) {
+	m.headerLimit = n
+}
+
+// WithRewindBudget tells the parser how many bytes in total it can backtrack over.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithRewindBudget option to NewParser instead.
+func (m *machine) WithRewindBudget(n int) {
+	m.rewindBudget = n
+}
+
+// WithRequiredScope tells the parser to require the scope.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithRequiredScope option to NewParser instead.
+func (m *machine) WithRequiredScope() {
+	m.requiredScope = true
+}
+
+// WithNoScopeWhitespace tells the parser to reject the scopes containing whitespace.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithNoScopeWhitespace option to NewParser instead.
+func (m *machine) WithNoScopeWhitespace() {
+	m.noScopeWhitespace = true
+}
+
+// WithStrictSpec tells the parser to enforce every MUST of the specification.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithStrictSpec option to NewParser instead.
+func (m *machine) WithStrictSpec() {
+	m.strictSpec = true
+}
+
+// WithTrivialDescriptionRule tells the parser to flag the trivial descriptions.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithTrivialDescriptionRule option to NewParser instead.
+func (m *machine) WithTrivialDescriptionRule(r conventionalcommits.TrivialDescriptionRule) {
+	m.trivialDescription = &r
+}
+
+// WithMaxHeaderLength tells the parser how many characters the first line can contain.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithMaxHeaderLength option to NewParser instead.
+func (m *machine) WithMaxHeaderLength(n int) {
+	m.maxHeaderLength = n
+}
+
+// WithNoTrailingPeriod tells the parser to reject the descriptions ending with a period.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithNoTrailingPeriod option to NewParser instead.
+func (m *machine) WithNoTrailingPeriod() {
+	m.noTrailingPeriod = true
+}
+
+// WithDescriptionCase tells the parser which case the first letter of the description must have.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithDescriptionCase option to NewParser instead.
+func (m *machine) WithDescriptionCase(c conventionalcommits.DescriptionCase) {
+	m.descriptionCase = c
+}
+
+// WithDuplicateFooterPolicy tells the parser what to do with the footer trailers repeating a token.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithDuplicateFooterPolicy option to NewParser instead.
+func (m *machine) WithDuplicateFooterPolicy(p conventionalcommits.DuplicateFooterPolicy) {
+	m.duplicateFooterPolicy = p
+}
+
+// WithRequiredChangeID makes a valid Gerrit Change-Id footer trailer mandatory.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithRequiredChangeID option to NewParser instead.
+func (m *machine) WithRequiredChangeID() {
+	m.requiredChangeID = true
+}
+
+// WithAllowedFooters tells the parser the only footer trailer tokens to accept.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithAllowedFooters option to NewParser instead.
+func (m *machine) WithAllowedFooters(tokens []string) {
+	// A copy, so that the caller can reuse the slice, keeping nil apart from empty
+	m.allowedFooters = append(tokens[:0:0], tokens...)
+}
+
+// WithStyleWarnings tells the parser to report the style issues as warnings.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithStyleWarnings option to NewParser instead.
+func (m *machine) WithStyleWarnings() {
+	m.styleWarnings = true
+}
+
+// WithMultipleScopes tells the parser to split the scope into multiple ones.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithMultipleScopes option to NewParser instead.
+func (m *machine) WithMultipleScopes() {
+	m.multipleScopes = true
+}
+
+// WithScopePath tells the parser to split the scope into a path.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithScopePath option to NewParser instead.
+func (m *machine) WithScopePath() {
+	m.scopePath = true
+}
+
+// WithScopeNormalization tells the parser to trim the whitespace around the scope.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithScopeNormalization option to NewParser instead.
+func (m *machine) WithScopeNormalization() {
+	m.scopeNormalization = true
+}
+
+// WithFooterKeyCase tells the parser the form of the footer trailer tokens in the ordered footers.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithFooterKeyCase option to NewParser instead.
+func (m *machine) WithFooterKeyCase(c conventionalcommits.FooterKeyCase) {
+	m.footerKeyCase = c
+}
+
+// WithLenientColonSpace tells the parser to accept a missing space after the colon.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithLenientColonSpace option to NewParser instead.
+func (m *machine) WithLenientColonSpace() {
+	m.lenientColonSpace = true
+}
+
+// WithLenientBlankLine tells the parser to accept a body right after the description.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithLenientBlankLine option to NewParser instead.
+func (m *machine) WithLenientBlankLine() {
+	m.lenientBlankLine = true
+}
+
+// WithTrimTrailingWhitespace tells the parser to ignore the trailing whitespace.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithTrimTrailingWhitespace option to NewParser instead.
+func (m *machine) WithTrimTrailingWhitespace() {
+	m.trimTrailingWhitespace = true
+}
+
+// WithSkipBOM tells the parser to skip the byte order mark at the beginning of the input.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithSkipBOM option to NewParser instead.
+func (m *machine) WithSkipBOM() {
+	m.skipByteOrderMark = true
+}
+
+// WithDescriptionSeparator tells the parser which white-space characters can separate the colon from the description.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithDescriptionSeparator option to NewParser instead.
+func (m *machine) WithDescriptionSeparator(s conventionalcommits.DescriptionSeparator) {
+	m.descriptionSeparator = s
+}
+
+// WithMultilineFooters tells the parser to fold the continuation lines into the footer trailer values.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithMultilineFooters option to NewParser instead.
+func (m *machine) WithMultilineFooters() {
+	m.multilineFooters = true
+}
+
+// WithErrorFormatter tells the parser how to render its errors.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithErrorFormatter option to NewParser instead.
+func (m *machine) WithErrorFormatter(f conventionalcommits.ErrorFormatter) {
+	m.errorFormatter = f
+}
+
+// WithPostprocessor tells the parser to run the given postprocessor on the messages it returns.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithPostprocessor option to NewParser instead.
+func (m *machine) WithPostprocessor(p conventionalcommits.Postprocessor) {
+	m.postprocessors = append(m.postprocessors, p)
 }
 
 // WithLogger tells the parser which logger to use.
+//
+// Deprecated: it mutates the machine, which is not safe for concurrent use.
+// Pass the WithLogger option to NewParser instead.
 func (m *machine) WithLogger(l *logrus.Logger) {
 	m.logger = l
 }
\ No newline at end of file
@@ -69,6 +69,41 @@ func TestMachineBestEffortOption(t *testing.T) {
 	assert.True(t, p2.HasBestEffort())
 }
 
+// baselineMachine implements only the methods every machine has.
+type baselineMachine struct {
+	bestEffort bool
+}
+
+func (m *baselineMachine) Parse(input []byte) (conventionalcommits.Message, error) {
+	return &conventionalcommits.ConventionalCommit{Type: "fix", Description: string(input)}, nil
+}
+
+func (m *baselineMachine) WithBestEffort() {
+	m.bestEffort = true
+}
+
+func (m *baselineMachine) HasBestEffort() bool {
+	return m.bestEffort
+}
+
+func (m *baselineMachine) WithTypes(t conventionalcommits.TypeConfig) {}
+
+func (m *baselineMachine) WithLogger(l *logrus.Logger) {}
+
+func TestMachineInterface(t *testing.T) {
+	// The machines implementing only the baseline methods stay machines
+	var m conventionalcommits.Machine = &baselineMachine{}
+	m = WithBestEffort()(m)
+	assert.True(t, m.HasBestEffort())
+
+	// The optional features are on their own interfaces
+	m = NewMachine()
+	_, ok := m.(conventionalcommits.ResultParser)
+	assert.True(t, ok)
+	_, ok = m.(conventionalcommits.RuleEnforcer)
+	assert.True(t, ok)
+}
+
 func TestParseLoggingErrorsOnly(t *testing.T) {
 	l, hook := logrustest.NewNullLogger()
 	l.SetLevel(logrus.ErrorLevel)
@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshal(t *testing.T) {
+	i := []byte("feat(api)!: add endpoint\n\nsee the issue\n\nfor details\n\nReviewed-by: Z\nBREAKING CHANGE: drop v1\nsigned-off-by: me")
+	msg, err := NewMachine().Parse(i)
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+	assert.Equal(t, "feat(api)!: add endpoint\n\nsee the issue\n\nfor details\n\nReviewed-by: Z\nBREAKING CHANGE: drop v1\nSigned-off-by: me", string(c.Marshal()))
+
+	// Parse, modify, and write back
+	c.Description = "add the users endpoint"
+	c.Body = nil
+	assert.Equal(t, "feat(api)!: add the users endpoint\n\nReviewed-by: Z\nBREAKING CHANGE: drop v1\nSigned-off-by: me", string(c.Marshal()))
+
+	// Without order, the trailers are sorted by token
+	c = &conventionalcommits.ConventionalCommit{Type: "fix", Description: "x", Footers: map[string][]string{"refs": {"#1"}, "acked-by": {"Y"}}}
+	assert.Equal(t, "fix: x\n\nAcked-by: Y\nRefs: #1", string(c.Marshal()))
+
+	// The trailers keep their separator
+	msg, err = NewMachine().Parse([]byte("fix: x\n\nRefs #133\nAcked-by: Y"))
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: x\n\nRefs #133\nAcked-by: Y", string(msg.(*conventionalcommits.ConventionalCommit).Marshal()))
+
+	// Without a token, the trailer has none
+	c = &conventionalcommits.ConventionalCommit{Type: "fix", Description: "x", OrderedFooters: []conventionalcommits.Footer{{Value: "y"}}}
+	assert.Equal(t, "fix: x\n\n: y", string(c.Marshal()))
+
+	res := newMachine().ParseResult([]byte("fix: x\n\nbody"))
+	assert.Equal(t, "fix: x\n\nbody", res.String())
+	assert.Equal(t, "", newMachine().ParseResult([]byte("x")).String())
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	p := NewMachine(WithTypes(conventionalcommits.TypesConventional))
+	for _, e := range cctesting.ValidExamples() {
+		msg, err := p.Parse(e.Message)
+		assert.Nil(t, err, e.Name)
+		again, err := p.Parse(msg.(*conventionalcommits.ConventionalCommit).Marshal())
+		if assert.Nil(t, err, e.Name) {
+			assert.Equal(t, msg, again, e.Name)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModifiers(t *testing.T) {
+	msg, err := NewMachine(WithMultipleScopes()).Parse([]byte("fix(api,cli): typo\n\nbody\n\nRefs: #1"))
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+
+	out, err := c.WithScope("parser")
+	assert.Nil(t, err)
+	out, err = out.WithType("feat")
+	assert.Nil(t, err)
+	out, err = out.WithDescription("handle empty payloads")
+	assert.Nil(t, err)
+	out, err = out.AddFooter("Reviewed-by", "Z")
+	assert.Nil(t, err)
+	out, err = out.AddFooter("BREAKING CHANGE", "drop v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "feat(parser): handle empty payloads\n\nbody\n\nRefs: #1\nReviewed-by: Z\nBREAKING CHANGE: drop v1", string(out.Marshal()))
+	assert.Equal(t, []string{"parser"}, out.Scopes())
+	assert.True(t, out.IsBreakingChange())
+
+	// The modified copies round trip through the parser
+	again, err := NewMachine().Parse(out.Marshal())
+	assert.Nil(t, err)
+	assert.Equal(t, out.Marshal(), again.(*conventionalcommits.ConventionalCommit).Marshal())
+
+	// The original message does not change
+	assert.Equal(t, "fix(api,cli): typo\n\nbody\n\nRefs: #1", string(c.Marshal()))
+	assert.Equal(t, []string{"api", "cli"}, c.Scopes())
+
+	out, err = c.WithScope("")
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: typo\n\nnew body\n\nRefs: #1", string(out.WithBody("new body").Marshal()))
+	assert.Equal(t, "fix: typo\n\nRefs: #1", string(out.WithBody(" ").Marshal()))
+
+	// The footers of the messages built by hand keep their sorted order
+	out, err = (&conventionalcommits.ConventionalCommit{Type: "fix", Description: "x", Footers: map[string][]string{"refs": {"#1"}, "acked-by": {"Y"}}}).AddFooter("Closes", "#2")
+	assert.Nil(t, err)
+	assert.Equal(t, "fix: x\n\nAcked-by: Y\nRefs: #1\nCloses: #2", string(out.Marshal()))
+
+	invalid := []func() error{
+		func() error { _, err := c.WithType(""); return err },
+		func() error { _, err := c.WithType("fix(api)"); return err },
+		func() error { _, err := c.WithScope(" "); return err },
+		func() error { _, err := c.WithScope("a)b"); return err },
+		func() error { _, err := c.WithDescription("x\n\ny"); return err },
+		func() error { _, err := c.AddFooter("Reviewed by", "Z"); return err },
+		func() error { _, err := c.AddFooter("Refs", "\n"); return err },
+	}
+	for _, f := range invalid {
+		assert.Error(t, f())
+	}
+	_, err = c.WithScope("a)b")
+	assert.EqualError(t, err, `invalid scope "a)b"`)
+}
@@ -0,0 +1,180 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Framing represents the ways commit messages follow each other in a stream.
+type Framing int
+
+const (
+	// FramingJSONLines reads a commit message per line, as a JSON string (eg., "fix: x\n\nbody").
+	FramingJSONLines Framing = iota
+	// FramingLengthPrefixed reads a commit message per record, made of its length in bytes, a newline, and its bytes.
+	FramingLengthPrefixed
+	// FramingNUL reads commit messages separated by NUL bytes, like the output of "git log -z --format=%B".
+	FramingNUL
+)
+
+// maxRecordSize is the maximum size of the records in a stream.
+const maxRecordSize = 1 << 20
+
+// RecordDiagnostic is the JSON representation of a diagnostic.
+type RecordDiagnostic struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Record is the JSON representation of the outcome of parsing a commit message of a stream.
+type Record struct {
+	Index        int                 `json:"index"`
+	Ok           bool                `json:"ok"`
+	Completeness string              `json:"completeness"`
+	Type         string              `json:"type,omitempty"`
+	Scope        *string             `json:"scope,omitempty"`
+	Exclamation  bool                `json:"exclamation,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	Body         *string             `json:"body,omitempty"`
+	Footers      map[string][]string `json:"footers,omitempty"`
+	Errors       []RecordDiagnostic  `json:"errors,omitempty"`
+	Warnings     []RecordDiagnostic  `json:"warnings,omitempty"`
+}
+
+// NewRecord creates the record of the commit message at the given index of a stream.
+func NewRecord(index int, res conventionalcommits.Result) Record {
+	r := Record{Index: index, Ok: res.Ok(), Completeness: res.Completeness.String()}
+	if c, ok := res.Message.(*conventionalcommits.ConventionalCommit); ok && c != nil {
+		r.Type = c.Type
+		r.Scope = c.Scope
+		r.Exclamation = c.Exclamation
+		r.Description = c.Description
+		r.Body = c.Body
+		r.Footers = c.Footers
+	}
+	for _, d := range res.Diagnostics() {
+		rd := RecordDiagnostic{
+			Code:       d.Code,
+			Message:    d.Message,
+			Start:      d.Range.Start,
+			End:        d.Range.End,
+			Line:       d.Position.Line,
+			Column:     d.Position.Column,
+			Suggestion: d.Suggestion,
+		}
+		if d.Severity == conventionalcommits.SeverityError {
+			r.Errors = append(r.Errors, rd)
+		} else {
+			r.Warnings = append(r.Warnings, rd)
+		}
+	}
+
+	return r
+}
+
+// ParseNDJSON parses the commit messages read from r, framed as f, and writes a JSON record per line to w.
+//
+// It skips the blank commit messages, unless length-prefixed.
+// The indexes of the records count the skipped NUL-separated commit messages, but not the blank lines.
+// A single machine, configured with the given options, parses all the commit messages.
+// It returns an error only when the stream is malformed or can't be read or written.
+func ParseNDJSON(r io.Reader, w io.Writer, f Framing, options ...conventionalcommits.MachineOption) error {
+	_, err := SummarizeNDJSON(r, w, f, options...)
+
+	return err
+}
+
+// SummarizeNDJSON is like ParseNDJSON, but it also returns the summary of the records it wrote,
+// up to the malformed record, if any.
+func SummarizeNDJSON(r io.Reader, w io.Writer, f Framing, options ...conventionalcommits.MachineOption) (Summary, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxRecordSize)
+	switch f {
+	case FramingLengthPrefixed:
+		scanner.Split(scanLengthPrefixed)
+	case FramingNUL:
+		scanner.Split(scanNUL)
+	}
+
+	m := newMachine(options...)
+	enc := json.NewEncoder(w)
+	summary := Summary{}
+	for index := 0; scanner.Scan(); index++ {
+		input := scanner.Bytes()
+		if f != FramingLengthPrefixed && len(bytes.TrimSpace(input)) == 0 {
+			if f == FramingJSONLines {
+				// The blank lines are not records, while the blank NUL-separated commit messages are
+				index--
+			}
+			continue
+		}
+		if f == FramingJSONLines {
+			var s string
+			if err := json.Unmarshal(input, &s); err != nil {
+				return summary, fmt.Errorf("record %d: %w", index, err)
+			}
+			input = []byte(s)
+		}
+		record := NewRecord(index, m.ParseResult(input))
+		if err := enc.Encode(record); err != nil {
+			return summary, err
+		}
+		summary.Add(record)
+	}
+
+	return summary, scanner.Err()
+}
+
+// scanLengthPrefixed is a bufio.SplitFunc returning the length-prefixed records, ignoring the blank lines between them.
+func scanLengthPrefixed(data []byte, atEOF bool) (int, []byte, error) {
+	skip := 0
+	for skip < len(data) && (data[skip] == '\n' || data[skip] == '\r') {
+		skip++
+	}
+	if skip == len(data) {
+		return skip, nil, nil
+	}
+	i := bytes.IndexByte(data[skip:], '\n')
+	if i < 0 {
+		if atEOF {
+			return 0, nil, fmt.Errorf("missing newline after the record length")
+		}
+		return skip, nil, nil
+	}
+	n, err := strconv.Atoi(string(bytes.TrimSpace(data[skip : skip+i])))
+	if err != nil || n < 0 {
+		return 0, nil, fmt.Errorf("invalid record length '%s'", data[skip:skip+i])
+	}
+	start := skip + i + 1
+	if len(data) < start+n {
+		if atEOF {
+			return 0, nil, fmt.Errorf("record shorter than %d bytes", n)
+		}
+		return skip, nil, nil
+	}
+
+	return start + n, data[start : start+n], nil
+}
+
+// scanNUL is a bufio.SplitFunc returning the NUL-separated records.
+func scanNUL(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
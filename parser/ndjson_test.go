@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNDJSON(t *testing.T) {
+	in := `"fix(api): correct typo\n\nRefs: #1"
+
+"feat:add endpoint"
+`
+	var out bytes.Buffer
+	summary, err := SummarizeNDJSON(strings.NewReader(in), &out, FramingJSONLines)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"index":0,"ok":true,"completeness":"full","type":"fix","scope":"api","description":"correct typo","footers":{"refs":["#1"]}}
+{"index":1,"ok":false,"completeness":"none","errors":[{"code":"description-init","message":"expecting at least one white-space (' ') character, got 'a' character","start":5,"end":6,"line":1,"column":6,"suggestion":"insert ' ' after the colon"}]}
+`, out.String())
+	assert.Equal(t, Summary{Total: 2, Passed: 1, Errors: 1, Codes: []CodeCount{{Code: "description-init", Count: 1}}}, summary)
+
+	out.Reset()
+	in = "9\nfix: typo\n19\nfeat: x\n\nbody\n\nfoot\n"
+	err = ParseNDJSON(strings.NewReader(in), &out, FramingLengthPrefixed, WithLenientColonSpace())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"index":0,"ok":true,"completeness":"full","type":"fix","description":"typo"}
+{"index":1,"ok":true,"completeness":"full","type":"feat","description":"x","body":"body\n\nfoot"}
+`, out.String())
+
+	err = ParseNDJSON(strings.NewReader("fix: typo\n"), &out, FramingJSONLines)
+	assert.EqualError(t, err, "record 0: invalid character 'i' in literal false (expecting 'a')")
+	err = ParseNDJSON(strings.NewReader("x\nfix"), &out, FramingLengthPrefixed)
+	assert.EqualError(t, err, "invalid record length 'x'")
+	err = ParseNDJSON(strings.NewReader("9\nfix"), &out, FramingLengthPrefixed)
+	assert.EqualError(t, err, "record shorter than 9 bytes")
+}
+
+func TestParseNDJSONWithNUL(t *testing.T) {
+	// The output of "git log -z --format=%B"
+	in := "fix: typo\n\nRefs: #1\n\x00feat: add x\n\x00"
+	var out bytes.Buffer
+	err := ParseNDJSON(strings.NewReader(in), &out, FramingNUL, WithTrimTrailingWhitespace())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"index":0,"ok":true,"completeness":"full","type":"fix","description":"typo","footers":{"refs":["#1"]}}
+{"index":1,"ok":true,"completeness":"full","type":"feat","description":"add x"}
+`, out.String())
+	// The blank commit messages count, so that the indexes match the commits
+	out.Reset()
+	err = ParseNDJSON(strings.NewReader("fix: a\x00\n\x00feat: b"), &out, FramingNUL)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"index":0,"ok":true,"completeness":"full","type":"fix","description":"a"}
+{"index":2,"ok":true,"completeness":"full","type":"feat","description":"b"}
+`, out.String())
+}
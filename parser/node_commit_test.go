@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeCommit(t *testing.T) {
+	msg, err := NewMachine().Parse([]byte("feat(api): add endpoint for @leodido\n\nCloses owner/repo#12, see #13\n\nBREAKING CHANGE: drop v1\nRefs: #14"))
+	assert.Nil(t, err)
+	out, err := json.Marshal(conventionalcommits.NewNodeCommit(msg.(*conventionalcommits.ConventionalCommit)))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "feat",
+		"scope": "api",
+		"subject": "add endpoint for @leodido",
+		"merge": null,
+		"header": "feat(api): add endpoint for @leodido",
+		"body": "Closes owner/repo#12, see #13",
+		"footer": "BREAKING CHANGE: drop v1\nRefs: #14",
+		"notes": [{"title": "BREAKING CHANGE", "text": "drop v1"}],
+		"references": [
+			{"action": "Closes", "owner": "owner", "repository": "repo", "issue": "12", "raw": "owner/repo#12", "prefix": "#"},
+			{"action": null, "owner": null, "repository": null, "issue": "13", "raw": "#13", "prefix": "#"},
+			{"action": null, "owner": null, "repository": null, "issue": "14", "raw": "#14", "prefix": "#"}
+		],
+		"mentions": ["leodido"],
+		"revert": null
+	}`, string(out))
+
+	// The trailers with the " #" separator reference the issues too
+	msg, err = NewMachine().Parse([]byte("fix: x\n\nCloses #133"))
+	assert.Nil(t, err)
+	n := conventionalcommits.NewNodeCommit(msg.(*conventionalcommits.ConventionalCommit))
+	assert.Equal(t, "Closes #133", *n.Footer)
+	action := "Closes"
+	assert.Equal(t, []conventionalcommits.NodeReference{{Action: &action, Issue: "133", Raw: "#133", Prefix: "#"}}, n.References)
+
+	msg, err = NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte("revert!: feat: add endpoint\n\nThis reverts commit 1a2b3c."))
+	assert.Nil(t, err)
+	n = conventionalcommits.NewNodeCommit(msg.(*conventionalcommits.ConventionalCommit))
+	assert.Equal(t, &conventionalcommits.NodeRevert{Header: "feat: add endpoint", Hash: "1a2b3c"}, n.Revert)
+	assert.Equal(t, []conventionalcommits.NodeNote{{Title: "BREAKING CHANGE", Text: "feat: add endpoint"}}, n.Notes)
+	assert.Nil(t, n.Footer)
+	assert.Empty(t, n.References)
+}
@@ -0,0 +1,18 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Normalize rewrites a commit message in canonical form, once a machine configured with the given options accepts it.
+//
+// See the Normalize method of the commit messages for the canonical form.
+// It returns the input as is, with the error, when the machine rejects it.
+func Normalize(input []byte, options ...conventionalcommits.MachineOption) ([]byte, error) {
+	msg, err := NewMachine(options...).Parse(input)
+	if err != nil {
+		return input, err
+	}
+
+	return msg.(*conventionalcommits.ConventionalCommit).Normalize().Marshal(), nil
+}
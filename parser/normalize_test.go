@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		input   string
+		options []conventionalcommits.MachineOption
+		output  string
+	}{
+		{"fix: x", nil, "fix: x"},
+		{"FIX(API):   correct typo ", nil, "fix(api): correct typo"},
+		{"fix( api ): x\n\n\n\nbody\n\n\n", []conventionalcommits.MachineOption{WithTrimTrailingWhitespace()}, "fix(api): x\n\nbody"},
+		{"feat!: x\n\nbody\n\nBREAKING-CHANGE: drop v1\nreviewed-by: Z", nil, "feat!: x\n\nbody\n\nBREAKING CHANGE: drop v1\nReviewed-by: Z"},
+		{"fix: x\n\nRefs #133\nAcked-by:   A", nil, "fix: x\n\nRefs #133\nAcked-by: A"},
+	}
+	for _, tc := range cases {
+		out, err := Normalize([]byte(tc.input), tc.options...)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.output, string(out), tc.input)
+	}
+
+	out, err := Normalize([]byte("fix x"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3))
+	assert.Equal(t, "fix x", string(out))
+
+	// Normalizing does not change the original message
+	c := &conventionalcommits.ConventionalCommit{Type: "FIX", Description: " x", Footers: map[string][]string{"refs": {"#1 "}}}
+	assert.Equal(t, "fix", c.Normalize().Type)
+	assert.Equal(t, "FIX", c.Type)
+	assert.Equal(t, []string{"#1"}, c.Normalize().Footers["refs"])
+	assert.Equal(t, []string{"#1 "}, c.Footers["refs"])
+	assert.Equal(t, c.Normalize(), c.Normalize().Normalize())
+}
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"regexp"
+
 	"github.com/reviewpad/go-conventionalcommits"
 	"github.com/sirupsen/logrus"
 )
@@ -24,6 +26,329 @@ func WithTypes(t conventionalcommits.TypeConfig) conventionalcommits.MachineOpti
 	}
 }
 
+// WithTypeRegistry let you choose the types at runtime.
+//
+// It takes precedence over the types chosen with WithTypes.
+func WithTypeRegistry(r *conventionalcommits.TypeRegistry) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.TypeMatcher).WithTypeRegistry(r)
+		return m
+	}
+}
+
+// WithCustomTypes restricts the commit message types to the given ones.
+//
+// Types are case sensitive.
+// It is a shortcut for WithTypeRegistry.
+func WithCustomTypes(types []string) conventionalcommits.MachineOption {
+	return WithTypeRegistry(conventionalcommits.NewTypeRegistryOf(types...))
+}
+
+// WithTypePattern makes the parser accept the types matching the given regular expression.
+//
+// The pattern should be anchored (eg., "^[a-z]{2,10}$") since any match within the type is enough.
+// It takes precedence over the type set and the type registry.
+func WithTypePattern(re *regexp.Regexp) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.TypeMatcher).WithTypePattern(re)
+		return m
+	}
+}
+
+// WithCaseInsensitiveTypes makes the parser match the types in the type registry regardless of their case.
+//
+// The built-in type sets are always case insensitive.
+func WithCaseInsensitiveTypes() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.TypeMatcher).WithCaseInsensitiveTypes()
+		return m
+	}
+}
+
+// WithHeaderLimit makes the parser reject inputs whose header is longer than n bytes, without parsing them.
+//
+// It protects from pathological inputs, like huge single-line messages.
+// The parser looks at most at the first n+1 bytes of the input to find the end of the header,
+// byte order mark included, before any lenient option amends the input.
+func WithHeaderLimit(n int) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.HeaderLimiter).WithHeaderLimit(n)
+		return m
+	}
+}
+
+// WithRewindBudget sets how many bytes in total the parser can backtrack over.
+//
+// The parser backtracks when some text looking like a footer trailer turns out to be body content.
+// By default, the budget is the length of the input, which keeps the parsing linear.
+func WithRewindBudget(n int) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RewindLimiter).WithRewindBudget(n)
+		return m
+	}
+}
+
+// WithRequiredScope makes the scope mandatory.
+func WithRequiredScope() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithRequiredScope()
+		return m
+	}
+}
+
+// WithNoScopeWhitespace rejects the scopes containing whitespace.
+//
+// With scope normalization, it only rejects whitespace within the trimmed scope.
+func WithNoScopeWhitespace() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithNoScopeWhitespace()
+		return m
+	}
+}
+
+// WithStrictSpec enforces every MUST of the Conventional Commits v1.0.0 specification the parser is otherwise permissive about.
+//
+// That is, a single space after the colon, a single blank line after the description and before the footer trailers,
+// and an uppercase breaking change token. The errors tell which item of the specification the message violates.
+// It can't tell whether types are nouns.
+func WithStrictSpec() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithStrictSpec()
+		return m
+	}
+}
+
+// WithTrivialDescriptionRule flags the descriptions carrying too little information, like "fix stuff" or "wip".
+//
+// By default, trivial descriptions are warnings, that only ParseResult returns.
+// Set the Error field of the rule to make them errors.
+func WithTrivialDescriptionRule(r conventionalcommits.TrivialDescriptionRule) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithTrivialDescriptionRule(r)
+		return m
+	}
+}
+
+// WithMaxHeaderLength limits the first line of the commit messages to n characters, like the common 72 or 100 characters limits.
+//
+// A longer first line is an ErrHeaderLength error, reported at the first character exceeding the limit.
+// It is a style issue, a warning with WithStyleWarnings and in best effort mode.
+//
+// Differently from WithHeaderLimit, which counts the bytes of the input before parsing it,
+// it counts the characters of the first line as written, before the lenient options amend it,
+// but without the byte order mark that WithSkipBOM skips.
+func WithMaxHeaderLength(n int) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithMaxHeaderLength(n)
+		return m
+	}
+}
+
+// WithNoTrailingPeriod rejects the descriptions ending with a period, like "fix: correct typo.".
+//
+// It is an ErrTrailingPeriod error, reported at the period.
+// It is a style issue, a warning with WithStyleWarnings and in best effort mode.
+func WithNoTrailingPeriod() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithNoTrailingPeriod()
+		return m
+	}
+}
+
+// WithDescriptionCase enforces the case of the first character of the description, when it is a letter.
+//
+// For example, with conventionalcommits.CaseLowerFirst, "fix: Correct typo" fails with an ErrDescriptionCase error.
+// It is a style issue, a warning with WithStyleWarnings and in best effort mode.
+func WithDescriptionCase(c conventionalcommits.DescriptionCase) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithDescriptionCase(c)
+		return m
+	}
+}
+
+// WithDuplicateFooterPolicy chooses what the parser does with the footer trailers repeating a token,
+// since workflows expect different things from repeated "Signed-off-by" or "Change-Id" trailers.
+//
+// By default, it keeps all the values (conventionalcommits.DuplicateFootersAppend).
+// With conventionalcommits.DuplicateFootersError, "fix: x\n\nChange-Id: I1\nChange-Id: I2" fails with an ErrDuplicateFooter error.
+// The other policies keep either the first or the last value, also in the source map.
+func WithDuplicateFooterPolicy(p conventionalcommits.DuplicateFooterPolicy) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithDuplicateFooterPolicy(p)
+		return m
+	}
+}
+
+// WithRequiredChangeID makes mandatory the Change-Id footer trailer Gerrit needs to track the revisions of a change,
+// with a valid ID, that is "I" followed by 40 lowercase hexadecimal digits.
+//
+// A commit message without it fails with an ErrChangeIDRequired error, one with an invalid ID with an ErrChangeID error.
+func WithRequiredChangeID() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithRequiredChangeID()
+		return m
+	}
+}
+
+// WithAllowedFooters makes the parser accept only the footer trailers with the given tokens (eg., "BREAKING CHANGE", "Refs", and "Signed-off-by").
+//
+// The tokens are case-insensitive, and "BREAKING CHANGE" also allows "BREAKING-CHANGE".
+// The other footer trailers fail with an ErrFooterNotAllowed error, which is a warning in best effort mode.
+func WithAllowedFooters(tokens []string) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithAllowedFooters(tokens)
+		return m
+	}
+}
+
+// WithStyleWarnings reports the style issues as warnings, that only ParseResult returns, rather than as errors.
+//
+// The style issues are the uppercase types (ErrTypeCase), and the violations of WithMaxHeaderLength,
+// WithNoTrailingPeriod, and WithDescriptionCase.
+// Differently from the best effort mode, the structural failures remain errors.
+// The best effort mode demotes the style issues too, but it doesn't warn about the uppercase types:
+// whichever enables the demotion, each issue is a single warning.
+func WithStyleWarnings() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.RuleEnforcer).WithStyleWarnings()
+		return m
+	}
+}
+
+// WithMultipleScopes enables the parsing of multiple comma-separated or slash-separated scopes.
+//
+// For example, the scopes of "fix(api,cli): ..." are "api" and "cli".
+func WithMultipleScopes() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.ScopeConfigurer).WithMultipleScopes()
+		return m
+	}
+}
+
+// WithScopePath enables the parsing of slash-separated hierarchical scopes.
+//
+// For example, the scope path of "feat(pkg/parser/footer): ..." is "pkg", "parser", and "footer".
+// With multiple scopes, slashes then separate the segments of a path rather than the scopes.
+func WithScopePath() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.ScopeConfigurer).WithScopePath()
+		return m
+	}
+}
+
+// WithScopeNormalization trims the whitespace around the scope.
+//
+// For example, the scope of "fix(Core ): ..." is "core", like the one of "fix(core): ...".
+// The parser always lowercases the scope.
+func WithScopeNormalization() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.ScopeConfigurer).WithScopeNormalization()
+		return m
+	}
+}
+
+// WithFooterKeyCase chooses the form of the footer trailer tokens in the ordered footers of the commit messages,
+// so that re-serializing them keeps the original casing (conventionalcommits.FooterKeyPreserve),
+// or canonicalizes it (conventionalcommits.FooterKeyTrainCase).
+//
+// By default, they are lowercase (conventionalcommits.FooterKeyLower).
+// Whatever the form, the keys of the Footers map stay lowercase, so that both forms are available.
+func WithFooterKeyCase(c conventionalcommits.FooterKeyCase) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.FooterConfigurer).WithFooterKeyCase(c)
+		return m
+	}
+}
+
+// WithLenientColonSpace makes the parser accept a missing space after the colon, like in "fix:typo in parser".
+//
+// ParseResult warns about it with an ErrMissingColonSpace warning.
+func WithLenientColonSpace() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithLenientColonSpace()
+		return m
+	}
+}
+
+// WithLenientBlankLine makes the parser accept a body on the line right after the description,
+// like GitHub's web editor and some IDEs produce.
+//
+// ParseResult warns about it with an ErrMissingBlankLineAtBeginning warning.
+func WithLenientBlankLine() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithLenientBlankLine()
+		return m
+	}
+}
+
+// WithTrimTrailingWhitespace makes the parser ignore the trailing blank lines of the input,
+// like the newline ending the output of "git log -1 --format=%B",
+// and the trailing whitespace of the description, the body, and the footer trailer values.
+func WithTrimTrailingWhitespace() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithTrimTrailingWhitespace()
+		return m
+	}
+}
+
+// WithSkipBOM makes the parser skip the UTF-8 byte order mark at the beginning of the input,
+// common when messages come from Windows editors.
+//
+// The columns in the errors still count the bytes of the byte order mark.
+func WithSkipBOM() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithSkipBOM()
+		return m
+	}
+}
+
+// WithDescriptionSeparator chooses the white-space characters which can separate the colon from the description,
+// like the tab in "fix:\tmessage" that some tools emit.
+//
+// ParseResult warns about the tabs with an ErrTabSeparator warning.
+func WithDescriptionSeparator(s conventionalcommits.DescriptionSeparator) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithDescriptionSeparator(s)
+		return m
+	}
+}
+
+// WithMultilineFooters makes the parser fold the lines continuing a footer trailer value into it,
+// as the specification allows the values to span multiple lines until the next trailer.
+//
+// Like "git interpret-trailers --unfold", it joins every continuation line, indented or not, with a single space.
+// A blank line still ends the value.
+func WithMultilineFooters() conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.LenientConfigurer).WithMultilineFooters()
+		return m
+	}
+}
+
+// WithErrorFormatter makes the errors of the parser render their messages with the given formatter,
+// rather than with their message template followed by the column.
+//
+// It lets embedders, like bots and CI annotators, fully control the rendering of the errors,
+// like encoding them as JSON or localizing them, without parsing their messages.
+func WithErrorFormatter(f conventionalcommits.ErrorFormatter) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.ErrorFormatterSetter).WithErrorFormatter(f)
+		return m
+	}
+}
+
+// WithPostprocessor runs the given postprocessor on the messages the parser returns, also the partial ones of the best effort mode.
+//
+// The postprocessors run in the order of their options, and the first one failing fails the parsing.
+// They compose the extraction of information from the messages, like references or classifications, into the parsing,
+// recording it with the Annotate method of the messages, for example.
+func WithPostprocessor(p conventionalcommits.Postprocessor) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		m.(conventionalcommits.PostprocessorSetter).WithPostprocessor(p)
+		return m
+	}
+}
+
 // WithLogger enables a logger during parsing.
 func WithLogger(l *logrus.Logger) conventionalcommits.MachineOption {
 	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
@@ -0,0 +1,303 @@
+package parser
+
+import (
+	"regexp"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/sirupsen/logrus"
+)
+
+// ParserConfig represents the immutable configuration of a Parser.
+type ParserConfig struct {
+	bestEffort             bool
+	typeConfig             conventionalcommits.TypeConfig
+	typeRegistry           *conventionalcommits.TypeRegistry
+	typePattern            *regexp.Regexp
+	caseInsensitiveTypes   bool
+	headerLimit            int
+	rewindBudget           int
+	requiredScope          bool
+	multipleScopes         bool
+	scopePath              bool
+	scopeNormalization     bool
+	noScopeWhitespace      bool
+	strictSpec             bool
+	lenientColonSpace      bool
+	lenientBlankLine       bool
+	trimTrailingWhitespace bool
+	skipByteOrderMark      bool
+	descriptionSeparator   conventionalcommits.DescriptionSeparator
+	multilineFooters       bool
+	trivialDescription     *conventionalcommits.TrivialDescriptionRule
+	maxHeaderLength        int
+	noTrailingPeriod       bool
+	descriptionCase        conventionalcommits.DescriptionCase
+	duplicateFooterPolicy  conventionalcommits.DuplicateFooterPolicy
+	requiredChangeID       bool
+	allowedFooters         []string
+	footerKeyCase          conventionalcommits.FooterKeyCase
+	styleWarnings          bool
+	errorFormatter         conventionalcommits.ErrorFormatter
+	postprocessors         []conventionalcommits.Postprocessor
+	logger                 *logrus.Logger
+}
+
+// NewParserConfig creates a parser configuration from the given options.
+func NewParserConfig(options ...conventionalcommits.MachineOption) ParserConfig {
+	m := &machine{}
+	for _, opt := range options {
+		opt(m)
+	}
+
+	return m.ParserConfig
+}
+
+// BestEffort tells whether the best effort mode is on or off.
+func (c ParserConfig) BestEffort() bool {
+	return c.bestEffort
+}
+
+// Types returns the set of commit message types.
+func (c ParserConfig) Types() conventionalcommits.TypeConfig {
+	return c.typeConfig
+}
+
+// TypeRegistry returns the registry of commit message types, if any.
+func (c ParserConfig) TypeRegistry() *conventionalcommits.TypeRegistry {
+	return c.typeRegistry
+}
+
+// TypePattern returns the regular expression the commit message types must match, if any.
+func (c ParserConfig) TypePattern() *regexp.Regexp {
+	return c.typePattern
+}
+
+// CaseInsensitiveTypes tells whether the types in the type registry match regardless of their case.
+func (c ParserConfig) CaseInsensitiveTypes() bool {
+	return c.caseInsensitiveTypes
+}
+
+// HeaderLimit returns the maximum length in bytes of the header, zero meaning no limit.
+func (c ParserConfig) HeaderLimit() int {
+	return c.headerLimit
+}
+
+// RewindBudget returns how many bytes in total the parser can backtrack over, zero meaning the input length.
+func (c ParserConfig) RewindBudget() int {
+	return c.rewindBudget
+}
+
+// RequiredScope tells whether the scope is mandatory.
+func (c ParserConfig) RequiredScope() bool {
+	return c.requiredScope
+}
+
+// MultipleScopes tells whether the parser splits the scope into multiple ones.
+func (c ParserConfig) MultipleScopes() bool {
+	return c.multipleScopes
+}
+
+// ScopePath tells whether the parser splits the scope into a path.
+func (c ParserConfig) ScopePath() bool {
+	return c.scopePath
+}
+
+// ScopeNormalization tells whether the parser trims the whitespace around the scope.
+func (c ParserConfig) ScopeNormalization() bool {
+	return c.scopeNormalization
+}
+
+// NoScopeWhitespace tells whether the parser rejects the scopes containing whitespace.
+func (c ParserConfig) NoScopeWhitespace() bool {
+	return c.noScopeWhitespace
+}
+
+// StrictSpec tells whether the parser enforces every MUST of the specification.
+func (c ParserConfig) StrictSpec() bool {
+	return c.strictSpec
+}
+
+// LenientColonSpace tells whether the parser accepts a missing space after the colon.
+func (c ParserConfig) LenientColonSpace() bool {
+	return c.lenientColonSpace
+}
+
+// LenientBlankLine tells whether the parser accepts a body right after the description.
+func (c ParserConfig) LenientBlankLine() bool {
+	return c.lenientBlankLine
+}
+
+// TrimTrailingWhitespace tells whether the parser ignores the trailing whitespace.
+func (c ParserConfig) TrimTrailingWhitespace() bool {
+	return c.trimTrailingWhitespace
+}
+
+// SkipBOM tells whether the parser skips the byte order mark at the beginning of the input.
+func (c ParserConfig) SkipBOM() bool {
+	return c.skipByteOrderMark
+}
+
+// DescriptionSeparator tells which white-space characters can separate the colon from the description.
+func (c ParserConfig) DescriptionSeparator() conventionalcommits.DescriptionSeparator {
+	return c.descriptionSeparator
+}
+
+// MultilineFooters tells whether the parser folds the continuation lines into the footer trailer values.
+func (c ParserConfig) MultilineFooters() bool {
+	return c.multilineFooters
+}
+
+// TrivialDescriptionRule returns the rule flagging the trivial descriptions, if any.
+func (c ParserConfig) TrivialDescriptionRule() *conventionalcommits.TrivialDescriptionRule {
+	return c.trivialDescription
+}
+
+// MaxHeaderLength returns how many characters the first line can contain, if limited.
+func (c ParserConfig) MaxHeaderLength() int {
+	return c.maxHeaderLength
+}
+
+// NoTrailingPeriod tells whether the parser rejects the descriptions ending with a period.
+func (c ParserConfig) NoTrailingPeriod() bool {
+	return c.noTrailingPeriod
+}
+
+// DescriptionCase tells which case the first letter of the description must have.
+func (c ParserConfig) DescriptionCase() conventionalcommits.DescriptionCase {
+	return c.descriptionCase
+}
+
+// DuplicateFooterPolicy tells what the parser does with the footer trailers repeating a token.
+func (c ParserConfig) DuplicateFooterPolicy() conventionalcommits.DuplicateFooterPolicy {
+	return c.duplicateFooterPolicy
+}
+
+// RequiredChangeID tells whether a valid Gerrit Change-Id footer trailer is mandatory.
+func (c ParserConfig) RequiredChangeID() bool {
+	return c.requiredChangeID
+}
+
+// AllowedFooters returns the only footer trailer tokens the parser accepts, or nil when it accepts any.
+func (c ParserConfig) AllowedFooters() []string {
+	return append(c.allowedFooters[:0:0], c.allowedFooters...)
+}
+
+// FooterKeyCase tells the form of the footer trailer tokens in the ordered footers.
+func (c ParserConfig) FooterKeyCase() conventionalcommits.FooterKeyCase {
+	return c.footerKeyCase
+}
+
+// StyleWarnings tells whether the parser reports the style issues as warnings.
+func (c ParserConfig) StyleWarnings() bool {
+	return c.styleWarnings
+}
+
+// ErrorFormatter returns the custom formatter of the errors, if any.
+func (c ParserConfig) ErrorFormatter() conventionalcommits.ErrorFormatter {
+	return c.errorFormatter
+}
+
+// Postprocessors returns the postprocessors of the messages, in the order they run.
+func (c ParserConfig) Postprocessors() []conventionalcommits.Postprocessor {
+	return append([]conventionalcommits.Postprocessor{}, c.postprocessors...)
+}
+
+// clone returns a copy of the receiving configuration, which shares no slice with it.
+func (c ParserConfig) clone() ParserConfig {
+	c.allowedFooters = append(c.allowedFooters[:0:0], c.allowedFooters...)
+	c.postprocessors = append([]conventionalcommits.Postprocessor(nil), c.postprocessors...)
+	return c
+}
+
+// registry returns the type registry, or the one of the preset registered at runtime, made case insensitive when required.
+func (c ParserConfig) registry() *conventionalcommits.TypeRegistry {
+	r := c.typeRegistry
+	if r == nil && !c.typeConfig.IsBuiltin() {
+		// Presets registered at runtime have no machine of their own
+		r = c.typeConfig.Registry()
+	}
+	if c.caseInsensitiveTypes && r != nil && !r.IsCaseInsensitive() {
+		return r.Clone().CaseInsensitive()
+	}
+	return r
+}
+
+// Logger returns the logger, if any.
+func (c ParserConfig) Logger() *logrus.Logger {
+	return c.logger
+}
+
+// Parser parses Conventional Commits according to an immutable configuration.
+//
+// Differently from the machines returned by NewMachine, it is safe for concurrent use,
+// since every parsing runs on its own machine.
+type Parser struct {
+	config ParserConfig
+}
+
+// NewParser creates a parser configured with the given options.
+func NewParser(options ...conventionalcommits.MachineOption) *Parser {
+	return NewParserWithConfig(NewParserConfig(options...))
+}
+
+// NewParserWithConfig creates a parser with the given configuration.
+func NewParserWithConfig(config ParserConfig) *Parser {
+	return &Parser{config: config.clone()}
+}
+
+// Config returns the configuration of the receiving parser.
+func (p *Parser) Config() ParserConfig {
+	return p.config
+}
+
+// Parse parses the input byte array as a Conventional Commit message.
+//
+// The given options override the configuration of the receiving parser for this parsing only.
+// The types they choose replace the ones of the configuration, whatever their precedence,
+// and WithConfig replaces the whole configuration, so that the parsing can also relax it.
+// See the Parse method of the machines returned by NewMachine for details.
+func (p *Parser) Parse(input []byte, options ...conventionalcommits.MachineOption) (conventionalcommits.Message, error) {
+	return p.machine(options...).Parse(input)
+}
+
+// ParseResult parses the input byte array as a Conventional Commit message.
+//
+// The given options override the configuration of the receiving parser for this parsing only.
+// See the ParseResult method of the machines returned by NewMachine for details.
+func (p *Parser) ParseResult(input []byte, options ...conventionalcommits.MachineOption) conventionalcommits.Result {
+	return p.machine(options...).ParseResult(input)
+}
+
+// machine creates a machine with the configuration of the receiving parser, overridden by the given options.
+func (p *Parser) machine(options ...conventionalcommits.MachineOption) *machine {
+	// Options append to the slices of the configuration, which the parsings running concurrently must not share
+	m := &machine{ParserConfig: p.config.clone()}
+	if len(options) > 0 {
+		overrides := &machine{}
+		for _, opt := range options {
+			opt(overrides)
+		}
+		if overrides.typesChosen {
+			// Otherwise, the type registry of the configuration would take precedence over the types of the options
+			m.typeConfig, m.typeRegistry, m.typePattern = conventionalcommits.TypesMinimal, nil, nil
+		}
+	}
+	for _, opt := range options {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithConfig replaces the whole configuration of the parser, so that the options following it apply on top of it.
+//
+// Given to the Parse method of a Parser, it can relax the configuration for a single parsing,
+// like to accept a legacy commit message without the strict checks of the parser.
+func WithConfig(c ParserConfig) conventionalcommits.MachineOption {
+	return func(m conventionalcommits.Machine) conventionalcommits.Machine {
+		if pm, ok := m.(*machine); ok {
+			pm.ParserConfig = c.clone()
+		}
+		return m
+	}
+}
@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParserParse(t *testing.T) {
+	for _, tc := range testCasesForConventionalTypes {
+		p := NewParser(WithTypes(conventionalcommits.TypesConventional))
+		m := newMachine(WithTypes(conventionalcommits.TypesConventional))
+
+		expectedMsg, expectedErr := m.Parse(tc.input)
+		msg, err := p.Parse(tc.input)
+		assert.Equal(t, expectedMsg, msg, tc.title)
+		assert.Equal(t, expectedErr, err, tc.title)
+		assert.Equal(t, m.ParseResult(tc.input), p.ParseResult(tc.input), tc.title)
+	}
+}
+
+func TestParserConcurrentParse(t *testing.T) {
+	p := NewParser(WithBestEffort())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, tc := range testCases {
+				msg, _ := p.Parse(tc.input)
+				assert.Equal(t, tc.partialValue, msg, tc.title)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestParserConcurrentParseWithOverrides(t *testing.T) {
+	noop := func(c *conventionalcommits.ConventionalCommit) error { return nil }
+	// Three postprocessors leave room in the backing array for a fourth one
+	p := NewParser(WithPostprocessor(noop), WithPostprocessor(noop), WithPostprocessor(noop), WithAllowedFooters([]string{"Refs"}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprint(i)
+			annotate := func(c *conventionalcommits.ConventionalCommit) error {
+				c.Annotate("parsing", id)
+				return nil
+			}
+			for j := 0; j < 100; j++ {
+				msg, err := p.Parse([]byte("fix: x\n\nRefs: 1"), WithPostprocessor(annotate))
+				assert.Nil(t, err)
+				assert.Equal(t, map[string]string{"parsing": id}, msg.(*conventionalcommits.ConventionalCommit).Annotations)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, p.Config().Postprocessors(), 3)
+}
+
+func TestParserConfig(t *testing.T) {
+	l := logrus.New()
+	r := conventionalcommits.NewTypeRegistry()
+	c := NewParserConfig(WithBestEffort(), WithTypes(conventionalcommits.TypesFreeForm), WithTypeRegistry(r), WithLogger(l))
+
+	assert.True(t, c.BestEffort())
+	assert.Equal(t, conventionalcommits.TypesFreeForm, c.Types())
+	assert.Same(t, r, c.TypeRegistry())
+	assert.Same(t, l, c.Logger())
+	assert.Equal(t, c, NewParserWithConfig(c).Config())
+	assert.False(t, NewParser().Config().BestEffort())
+}
+
+func TestParserParseWithOverrides(t *testing.T) {
+	p := NewParser(WithTypes(conventionalcommits.TypesConventional))
+	i := []byte("fix: legacy\nmissing blank line")
+
+	msg, err := p.Parse(i)
+	assert.Nil(t, msg)
+	assert.Error(t, err)
+
+	msg, err = p.Parse(i, WithBestEffort())
+	assert.NotNil(t, msg)
+	assert.Error(t, err)
+	res := p.ParseResult(i, WithBestEffort())
+	assert.Equal(t, conventionalcommits.CompletenessHeaderOnly, res.Completeness)
+
+	// Overrides do not leak into the parser configuration
+	assert.False(t, p.Config().BestEffort())
+	msg, _ = p.Parse(i)
+	assert.Nil(t, msg)
+
+	msg, err = p.Parse([]byte("deps: x"), WithTypes(conventionalcommits.TypesFreeForm))
+	assert.Nil(t, err)
+	assert.Equal(t, "deps", msg.(*conventionalcommits.ConventionalCommit).Type)
+
+	// The types of the options win over the type registry of the configuration
+	p = NewParser(WithTypeRegistry(conventionalcommits.NewTypeRegistry(conventionalcommits.TypeDefinition{Name: "feat"}, conventionalcommits.TypeDefinition{Name: "fix"})))
+	_, err = p.Parse([]byte("deps: x"))
+	assert.Error(t, err)
+	msg, err = p.Parse([]byte("deps: x"), WithTypes(conventionalcommits.TypesFreeForm))
+	assert.Nil(t, err)
+	assert.Equal(t, "deps", msg.(*conventionalcommits.ConventionalCommit).Type)
+	_, err = p.Parse([]byte("deps: x"))
+	assert.Error(t, err)
+
+	// WithConfig relaxes the configuration
+	p = NewParser(WithBestEffort())
+	msg, _ = p.Parse(i, WithConfig(NewParserConfig()))
+	assert.Nil(t, msg)
+	msg, _ = p.Parse(i, WithConfig(NewParserConfig()), WithBestEffort())
+	assert.NotNil(t, msg)
+	assert.True(t, p.Config().BestEffort())
+}
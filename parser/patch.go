@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"bytes"
+	"mime"
+	"regexp"
+	"strings"
+)
+
+var (
+	mboxSeparator = []byte("From ")
+	mboxHeader    = regexp.MustCompile(`^[A-Za-z0-9-]+:`)
+	subjectPrefix = regexp.MustCompile(`^(\[[^\]]*\]\s*)+`)
+	headerDecoder = new(mime.WordDecoder)
+)
+
+// MessageFromPatch extracts the commit message from a patch email generated by git format-patch.
+//
+// It drops the mail headers except for the subject, which becomes the first line of the commit message
+// once its "[PATCH ...]" prefixes are removed.
+// It also drops everything from the "---" line on, ie. the diffstat and the patch itself.
+func MessageFromPatch(patch []byte) []byte {
+	lines := strings.Split(strings.ReplaceAll(string(patch), "\r\n", "\n"), "\n")
+
+	i := 0
+	// Skip the mbox separator line
+	if len(lines) > 0 && strings.HasPrefix(lines[0], string(mboxSeparator)) {
+		i++
+	}
+
+	// Mail headers, folded ones included, until the first blank line
+	subject := ""
+	inSubject := false
+	for ; i < len(lines) && lines[i] != ""; i++ {
+		line := lines[i]
+		switch {
+		case inSubject && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")):
+			subject += " " + strings.TrimSpace(line)
+		case strings.HasPrefix(strings.ToLower(line), "subject:"):
+			subject = strings.TrimSpace(line[len("subject:"):])
+			inSubject = true
+		default:
+			inSubject = false
+		}
+	}
+	if decoded, err := headerDecoder.DecodeHeader(subject); err == nil {
+		subject = decoded
+	}
+	subject = subjectPrefix.ReplaceAllString(subject, "")
+
+	// Body, until the patch separator
+	body := []string{}
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if line == "---" || strings.HasPrefix(line, "diff --git ") || strings.HasPrefix(line, "Index: ") {
+			break
+		}
+		body = append(body, line)
+	}
+	for len(body) > 0 && strings.TrimSpace(body[0]) == "" {
+		body = body[1:]
+	}
+	for len(body) > 0 && strings.TrimSpace(body[len(body)-1]) == "" {
+		body = body[:len(body)-1]
+	}
+
+	if len(body) == 0 {
+		return []byte(subject)
+	}
+	return []byte(subject + "\n\n" + strings.Join(body, "\n"))
+}
+
+// MessagesFromMbox extracts the commit messages from a mbox file containing patch emails.
+//
+// A new email starts at every line beginning with "From " that is the first one or follows a blank line,
+// provided that a mail header comes next.
+func MessagesFromMbox(mbox []byte) [][]byte {
+	mbox = bytes.ReplaceAll(mbox, []byte("\r\n"), []byte("\n"))
+
+	messages := [][]byte{}
+	start := -1
+	for off := 0; off < len(mbox); {
+		end := bytes.IndexByte(mbox[off:], '\n')
+		if end < 0 {
+			end = len(mbox)
+		} else {
+			end += off + 1
+		}
+		if bytes.HasPrefix(mbox[off:], mboxSeparator) && (off == 0 || (off >= 2 && mbox[off-2] == '\n')) && mboxHeader.Match(mbox[end:]) {
+			if start >= 0 {
+				messages = append(messages, MessageFromPatch(mbox[start:off]))
+			}
+			start = off
+		}
+		off = end
+	}
+	if start >= 0 {
+		messages = append(messages, MessageFromPatch(mbox[start:]))
+	}
+
+	return messages
+}
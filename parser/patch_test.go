@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+var formatPatch = `From 6a1e3c1f2b1a8d3c4e5f60718293a4b5c6d7e8f9 Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Date: Mon, 3 Oct 2022 10:00:00 +0200
+Subject: [PATCH v2 1/2] fix(parser): handle folded
+ subjects
+
+see the issue for details
+
+Signed-off-by: Jane Doe <jane@example.com>
+---
+ parser/machine.go | 2 +-
+ 1 file changed, 1 insertion(+), 1 deletion(-)
+
+diff --git a/parser/machine.go b/parser/machine.go
+index 1111111..2222222 100644
+-- 
+2.37.0
+
+`
+
+func TestMessageFromPatch(t *testing.T) {
+	expected := "fix(parser): handle folded subjects\n\nsee the issue for details\n\nSigned-off-by: Jane Doe <jane@example.com>"
+	assert.Equal(t, expected, string(MessageFromPatch([]byte(formatPatch))))
+
+	res, err := NewMachine().Parse(MessageFromPatch([]byte(formatPatch)))
+	assert.Nil(t, err)
+	assert.Equal(t, &conventionalcommits.ConventionalCommit{
+		Type:        "fix",
+		Scope:       cctesting.StringAddress("parser"),
+		Description: "handle folded subjects",
+		Body:        cctesting.StringAddress("see the issue for details"),
+		Footers: map[string][]string{
+			"signed-off-by": {"Jane Doe <jane@example.com>"},
+		},
+		OrderedFooters: []conventionalcommits.Footer{
+			{Token: "signed-off-by", Separator: ": ", Value: "Jane Doe <jane@example.com>"},
+		},
+	}, res)
+}
+
+func TestMessageFromPatchWithoutBody(t *testing.T) {
+	patch := "From: Jane Doe <jane@example.com>\r\nSubject: [PATCH] =?UTF-8?q?feat:=20caf=C3=A9?=\r\n\r\n---\r\n a | 1 +\r\n"
+	assert.Equal(t, "feat: café", string(MessageFromPatch([]byte(patch))))
+}
+
+func TestMessagesFromMbox(t *testing.T) {
+	mbox := formatPatch + `From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001
+From: John Doe <john@example.com>
+Subject: [PATCH 2/2] feat: second
+
+From now on this is a body line.
+---
+`
+	messages := MessagesFromMbox([]byte(mbox))
+	assert.Len(t, messages, 2)
+	assert.Equal(t, "fix(parser): handle folded subjects\n\nsee the issue for details\n\nSigned-off-by: Jane Doe <jane@example.com>", string(messages[0]))
+	assert.Equal(t, "feat: second\n\nFrom now on this is a body line.", string(messages[1]))
+}
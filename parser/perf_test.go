@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/reviewpad/go-conventionalcommits"
@@ -95,6 +96,23 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 	},
 }
 
+var longLine = strings.Repeat("a", 1<<20)
+
+var pathologicalBenchCases = []benchCase{
+	{
+		label: "[ok] 1MB long description",
+		input: []byte("fix: " + longLine),
+	},
+	{
+		label: "[ok] 1MB long body line",
+		input: []byte("fix: x\n\n" + longLine),
+	},
+	{
+		label: "[no] 1MB long type",
+		input: []byte(longLine),
+	},
+}
+
 func BenchmarkSlimParseMinimalTypes(b *testing.B) {
 	for _, tc := range benchCases {
 		tc := tc
@@ -118,3 +136,39 @@ func BenchmarkSlimParseConventionalTypes(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkSlimParsePathological(b *testing.B) {
+	for _, tc := range pathologicalBenchCases {
+		tc := tc
+		m := NewMachine(WithBestEffort(), WithTypes(conventionalcommits.TypesFreeForm))
+		b.Run(cctesting.RightPad(tc.label, 50), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchParseResult, _ = m.Parse(tc.input)
+			}
+		})
+	}
+}
+
+func BenchmarkSlimParsePathologicalWithHeaderLimit(b *testing.B) {
+	for _, tc := range pathologicalBenchCases {
+		tc := tc
+		m := NewMachine(WithBestEffort(), WithTypes(conventionalcommits.TypesFreeForm), WithHeaderLimit(100))
+		b.Run(cctesting.RightPad(tc.label, 50), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchParseResult, _ = m.Parse(tc.input)
+			}
+		})
+	}
+}
+
+func BenchmarkSlimParseProfiles(b *testing.B) {
+	for _, p := range cctesting.Profiles {
+		messages := cctesting.Generate(p, 1000, 1)
+		m := NewMachine(WithBestEffort(), WithTypes(conventionalcommits.TypesConventional))
+		b.Run(cctesting.RightPad(p.Name, 50), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchParseResult, _ = m.Parse(messages[i%len(messages)])
+			}
+		})
+	}
+}
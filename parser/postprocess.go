@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// postprocess runs the postprocessors on the message the parser returns with the given error, if any.
+//
+// It returns the error of the first failing postprocessor, without the message.
+func (m *machine) postprocess(msg conventionalcommits.Message, err error) (conventionalcommits.Message, error) {
+	c := msg.(*conventionalcommits.ConventionalCommit)
+	for _, p := range m.postprocessors {
+		if e := p(c); e != nil {
+			return nil, e
+		}
+	}
+
+	return c, err
+}
@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostprocessors(t *testing.T) {
+	issues := regexp.MustCompile(`#[0-9]+`)
+	references := func(c *conventionalcommits.ConventionalCommit) error {
+		refs := issues.FindAllString(c.Description+strings.Join(c.Footer("Refs"), " "), -1)
+		if len(refs) > 0 {
+			c.Annotate("references", strings.Join(refs, ","))
+		}
+		return nil
+	}
+	public := func(c *conventionalcommits.ConventionalCommit) error {
+		c.Annotate("public-api", fmt.Sprint(c.AffectsPublicAPI()))
+		return nil
+	}
+	p := NewMachine(WithPostprocessor(references), WithPostprocessor(public))
+
+	res, err := p.Parse([]byte("fix: crash on #12\n\nRefs: #13"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"references": "#12,#13", "public-api": "true"}, res.(*conventionalcommits.ConventionalCommit).Annotations)
+
+	res, err = NewMachine(WithTypes(conventionalcommits.TypesConventional), WithPostprocessor(references), WithPostprocessor(public)).Parse([]byte("docs: typo"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"public-api": "false"}, res.(*conventionalcommits.ConventionalCommit).Annotations)
+
+	// They also run on the partial messages of the best effort mode
+	res, err = NewMachine(WithBestEffort(), WithPostprocessor(public)).Parse([]byte("feat: x\nbody"))
+	assert.Error(t, err)
+	assert.Equal(t, "true", res.(*conventionalcommits.ConventionalCommit).Annotations["public-api"])
+
+	// Failing postprocessors fail the parsing
+	failing := func(c *conventionalcommits.ConventionalCommit) error {
+		return fmt.Errorf("unexpected type %s", c.Type)
+	}
+	res, err = NewMachine(WithPostprocessor(failing), WithPostprocessor(public)).Parse([]byte("fix: x"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "unexpected type fix")
+
+	assert.Len(t, NewParserConfig(WithPostprocessor(public)).Postprocessors(), 1)
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterTypeConfig(t *testing.T) {
+	t.Cleanup(func() {
+		conventionalcommits.UnregisterTypeConfig("kubernetes-test")
+	})
+	k8s := conventionalcommits.RegisterTypeConfig("kubernetes-test", conventionalcommits.NewTypeRegistry(
+		conventionalcommits.TypeDefinition{Name: "feat", Aliases: []string{"feature"}},
+		conventionalcommits.TypeDefinition{Name: "fix"},
+		conventionalcommits.TypeDefinition{Name: "kep"},
+	))
+	assert.False(t, k8s.IsBuiltin())
+	assert.Equal(t, k8s, conventionalcommits.Lookup("kubernetes-test"))
+	assert.Equal(t, conventionalcommits.TypesConventional, conventionalcommits.Lookup("conventional"))
+	assert.True(t, conventionalcommits.Capabilities().Has("kubernetes-test"))
+
+	p := NewMachine(WithTypes(conventionalcommits.Lookup("kubernetes-test")))
+	res, err := p.Parse([]byte("kep(sig-node): add proposal"))
+	assert.Nil(t, err)
+	assert.Equal(t, "kep", res.(*conventionalcommits.ConventionalCommit).Type)
+
+	res, err = p.Parse([]byte("feature: x"))
+	assert.Nil(t, err)
+	assert.Equal(t, "feat", res.(*conventionalcommits.ConventionalCommit).Type)
+
+	res, err = p.Parse([]byte("docs: x"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "d", 0))
+
+	// Registering the name again replaces the types
+	assert.Equal(t, k8s, conventionalcommits.RegisterTypeConfig("kubernetes-test", conventionalcommits.NewTypeRegistryOf("docs")))
+	_, err = p.Parse([]byte("docs: x"))
+	assert.Nil(t, err)
+
+	assert.Panics(t, func() {
+		conventionalcommits.RegisterTypeConfig("minimal", conventionalcommits.NewTypeRegistryOf("docs"))
+	})
+
+	// The parsers share the copy of the registry made when registering it
+	assert.Same(t, k8s.Registry(), k8s.Registry())
+}
+
+func TestUnregisterTypeConfig(t *testing.T) {
+	c := conventionalcommits.RegisterTypeConfig("unregister-test", conventionalcommits.NewTypeRegistryOf("kep"))
+	p := NewMachine(WithTypes(c))
+	_, err := p.Parse([]byte("kep: x"))
+	assert.Nil(t, err)
+
+	assert.True(t, conventionalcommits.UnregisterTypeConfig("unregister-test"))
+	assert.False(t, conventionalcommits.UnregisterTypeConfig("unregister-test"))
+	assert.False(t, conventionalcommits.UnregisterTypeConfig("minimal"))
+	assert.Equal(t, conventionalcommits.TypesUnknown, conventionalcommits.Lookup("unregister-test"))
+	assert.False(t, conventionalcommits.Capabilities().Has("unregister-test"))
+	assert.Equal(t, conventionalcommits.TypesMinimal, conventionalcommits.Lookup("minimal"))
+
+	_, err = p.Parse([]byte("kep: x"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "k", 0))
+}
+
+func TestLookupUnknownTypeConfig(t *testing.T) {
+	assert.Equal(t, conventionalcommits.TypesUnknown, conventionalcommits.Lookup("unknown"))
+
+	res, err := NewMachine(WithTypes(conventionalcommits.Lookup("unknown"))).Parse([]byte("fix: x"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "f", 0))
+}
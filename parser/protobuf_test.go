@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtobuf(t *testing.T) {
+	c := &conventionalcommits.ConventionalCommit{Type: "fix", Description: "x"}
+	data, err := c.MarshalProto()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x0a, 3, 'f', 'i', 'x', 0x12, 1, 'x'}, data)
+
+	msg, err := NewMachine(WithMultipleScopes()).Parse([]byte("feat(api,cli)!: add endpoint\n\nbody\n\nRefs: #1\nAcked-by: Y\nRefs: #2"))
+	assert.Nil(t, err)
+	c = msg.(*conventionalcommits.ConventionalCommit)
+	c.Annotate("team", "core")
+	data, err = c.MarshalProto()
+	assert.Nil(t, err)
+	out, err := conventionalcommits.UnmarshalProto(data)
+	assert.Nil(t, err)
+	assert.Equal(t, c, out)
+
+	// The unknown fields are skipped
+	out, err = conventionalcommits.UnmarshalProto(append(data, 0x50, 0x01, 0x5d, 0, 0, 0, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, c, out)
+
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x0a, 5, 'f'})
+	assert.EqualError(t, err, "truncated protobuf message")
+}
+
+// TestProtobufConformance checks the encoding against the one of the protobuf runtime for proto/conventional_commit.proto.
+func TestProtobufConformance(t *testing.T) {
+	c := &conventionalcommits.ConventionalCommit{
+		Type:           "feat",
+		Description:    "add",
+		Scope:          cctesting.StringAddress("api"),
+		Exclamation:    true,
+		Body:           cctesting.StringAddress(""),
+		Footers:        map[string][]string{"refs": {"#1"}},
+		OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}},
+		Annotations:    map[string]string{"team": "core"},
+	}
+	// The bytes the protobuf runtime outputs for the same message, with deterministic marshaling
+	golden := []byte{
+		0x0a, 0x04, 'f', 'e', 'a', 't', // type
+		0x12, 0x03, 'a', 'd', 'd', // description
+		0x1a, 0x03, 'a', 'p', 'i', // scope
+		0x30, 0x01, // exclamation
+		0x3a, 0x00, // body, present though empty
+		0x42, 0x0e, 0x0a, 0x04, 'r', 'e', 'f', 's', 0x12, 0x02, '#', '1', 0x1a, 0x02, ':', ' ', // footers
+		0x4a, 0x0c, 0x0a, 0x04, 't', 'e', 'a', 'm', 0x12, 0x04, 'c', 'o', 'r', 'e', // annotations
+	}
+	data, err := c.MarshalProto()
+	assert.Nil(t, err)
+	assert.Equal(t, golden, data)
+	out, err := conventionalcommits.UnmarshalProto(golden)
+	assert.Nil(t, err)
+	assert.Equal(t, c, out)
+
+	// The empty strings of the footer trailers are implicit
+	c = &conventionalcommits.ConventionalCommit{Type: "fix", OrderedFooters: []conventionalcommits.Footer{{Token: "refs"}}}
+	data, err = c.MarshalProto()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x0a, 0x03, 'f', 'i', 'x', 0x42, 0x06, 0x0a, 0x04, 'r', 'e', 'f', 's'}, data)
+
+	// The strings are valid UTF-8
+	_, err = (&conventionalcommits.ConventionalCommit{Type: "fix", Description: "\xff"}).MarshalProto()
+	assert.EqualError(t, err, "protobuf string field containing invalid UTF-8")
+	_, err = (&conventionalcommits.ConventionalCommit{Annotations: map[string]string{"a": "\xc3"}}).MarshalProto()
+	assert.EqualError(t, err, "protobuf string field containing invalid UTF-8")
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x12, 0x01, 0xff})
+	assert.EqualError(t, err, "protobuf string field containing invalid UTF-8")
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x42, 0x03, 0x12, 0x01, 0xff})
+	assert.EqualError(t, err, "protobuf string field containing invalid UTF-8")
+
+	// The known fields have the wire type of their declaration
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x08, 0x01})
+	assert.EqualError(t, err, "protobuf field 1 with wire type 0, expecting 2")
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x32, 0x01, 0x01})
+	assert.EqualError(t, err, "protobuf field 6 with wire type 2, expecting 0")
+	_, err = conventionalcommits.UnmarshalProto([]byte{0x42, 0x02, 0x10, 0x01})
+	assert.EqualError(t, err, "protobuf field 2 with wire type 0, expecting 2")
+}
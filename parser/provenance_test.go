@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvenanceTrailers(t *testing.T) {
+	i := []byte(`feat: release artifacts
+
+Provenance: https://example.com/attestations/1.intoto.jsonl
+SLSA-Build: https://github.com/org/repo/actions/runs/42
+Attestation: sha256:6b8d3c1f2b1a8d3c4e5f60718293a4b5c6d7e8f96b8d3c1f2b1a8d3c4e5f6071`)
+	m, err := NewMachine().Parse(i)
+	assert.Nil(t, err)
+
+	c := m.(*conventionalcommits.ConventionalCommit)
+	assert.Nil(t, c.ValidateProvenance())
+
+	refs := c.Provenance()
+	assert.Len(t, refs, 3)
+	assert.Equal(t, conventionalcommits.FooterProvenance, refs[0].Token)
+	assert.Equal(t, "example.com", refs[0].URL.Host)
+	assert.Equal(t, conventionalcommits.FooterSLSABuild, refs[1].Token)
+	assert.Equal(t, "/org/repo/actions/runs/42", refs[1].URL.Path)
+	assert.Equal(t, conventionalcommits.FooterAttestation, refs[2].Token)
+	assert.Nil(t, refs[2].URL)
+	assert.Equal(t, "sha256:6b8d3c1f2b1a8d3c4e5f60718293a4b5c6d7e8f96b8d3c1f2b1a8d3c4e5f6071", refs[2].Digest)
+}
+
+func TestInvalidProvenanceTrailers(t *testing.T) {
+	m, err := NewMachine().Parse([]byte("feat: x\n\nProvenance: https://example.com/a\nSLSA-Build: somewhere"))
+	assert.Nil(t, err)
+
+	c := m.(*conventionalcommits.ConventionalCommit)
+	assert.EqualError(t, c.ValidateProvenance(), "invalid slsa-build trailer value 'somewhere': expecting an absolute URL or a digest")
+	assert.Len(t, c.Provenance(), 1)
+}
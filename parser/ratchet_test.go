@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func metrics(inputs ...string) conventionalcommits.Metrics {
+	p := newMachine()
+	m := conventionalcommits.Metrics{}
+	for _, i := range inputs {
+		m.Add([]byte(i), p.ParseResult([]byte(i)))
+	}
+	return m
+}
+
+func TestRatchet(t *testing.T) {
+	previous := metrics("fix: typo", "feat: add x\n\nbody", "wip")
+	assert.Equal(t, conventionalcommits.Metrics{Total: 3, Compliant: 2, HeaderCharacters: 23}, previous)
+	assert.InDelta(t, 0.667, previous.Compliance(), 0.001)
+
+	r := conventionalcommits.DefaultRatchet
+	assert.Nil(t, r.Check(previous, metrics("fix: typo", "fix: bug", "fix: x")))
+	assert.EqualError(t, r.Check(previous, metrics("fix: typo", "oops", "wip")), "compliance decreased from 66.67% to 33.33%")
+	assert.EqualError(t, r.Check(previous, metrics("fix: a much longer description")), "average header length grew from 7.67 to 30.00 characters")
+	assert.Nil(t, conventionalcommits.Ratchet{Compliance: true}.Check(previous, metrics("fix: a much longer description")))
+
+	assert.Equal(t, 1.0, conventionalcommits.Metrics{}.Compliance())
+}
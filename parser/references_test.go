@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFooterValuesWithHashes(t *testing.T) {
+	cases := []struct {
+		input  string
+		footer conventionalcommits.Footer
+	}{
+		{"fix: x\n\nRefs: https://github.com/org/repo/issues/1#issuecomment-2", conventionalcommits.Footer{Token: "refs", Separator: ": ", Value: "https://github.com/org/repo/issues/1#issuecomment-2"}},
+		{"fix: x\n\nRefs: #12 #13", conventionalcommits.Footer{Token: "refs", Separator: ": ", Value: "#12 #13"}},
+		{"fix: x\n\nRefs: org/repo#3", conventionalcommits.Footer{Token: "refs", Separator: ": ", Value: "org/repo#3"}},
+		{"fix: x\n\nFixes #12 and #13", conventionalcommits.Footer{Token: "fixes", Separator: " #", Value: "12 and #13"}},
+		{"fix: x\n\nRefs ##12", conventionalcommits.Footer{Token: "refs", Separator: " #", Value: "#12"}},
+		{"fix: x\n\nRefs: a # b", conventionalcommits.Footer{Token: "refs", Separator: ": ", Value: "a # b"}},
+	}
+	for _, tc := range cases {
+		msg, err := NewMachine().Parse([]byte(tc.input))
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, []conventionalcommits.Footer{tc.footer}, msg.(*conventionalcommits.ConventionalCommit).OrderedFooters, tc.input)
+	}
+
+	msg, err := NewMachine().Parse([]byte("fix: x\n\nFixes #12 and #13\nRefs: a # b\nfixes: #14"))
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+	assert.Equal(t, []string{"#12 and #13", "#14"}, c.VerbatimFooter("Fixes"))
+	assert.Equal(t, []string{"a # b"}, c.VerbatimFooter("refs"))
+	assert.Equal(t, []string{"12 and #13", "#14"}, c.Footers["fixes"])
+	assert.Equal(t, []string{"12 and #13"}, (&conventionalcommits.ConventionalCommit{Footers: map[string][]string{"fixes": {"12 and #13"}}}).VerbatimFooter("fixes"))
+
+	// The separators without a value at the end of the input are incomplete trailers
+	incomplete := []struct {
+		input string
+		char  string
+		col   int
+	}{
+		{"fix: x\n\nRefs #", "#", 14},
+		{"fix: x\n\nRefs: ", " ", 14},
+		{"fix: x\n\nbody\n\nRefs #", "#", 20},
+		{"fix: x\n\nRefs: #1\nFixes #", "#", 24},
+	}
+	for _, tc := range incomplete {
+		msg, err := NewMachine().Parse([]byte(tc.input))
+		assert.Nil(t, msg, tc.input)
+		assert.EqualError(t, err, fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, tc.char, tc.col), tc.input)
+
+		msg, err = NewMachine(WithBestEffort()).Parse([]byte(tc.input))
+		assert.Error(t, err, tc.input)
+		assert.Equal(t, "x", msg.(*conventionalcommits.ConventionalCommit).Description, tc.input)
+	}
+}
+
+func TestReferences(t *testing.T) {
+	assert.Equal(t, []conventionalcommits.Reference{
+		{Token: "refs", Owner: "org", Repository: "repo", Issue: "1", URL: "https://github.com/org/repo/issues/1#issuecomment-2", Raw: "https://github.com/org/repo/issues/1#issuecomment-2"},
+		{Token: "refs", Issue: "4", Raw: "#4"},
+		{Token: "refs", Owner: "group", Repository: "project", Issue: "7", URL: "https://gitlab.com/group/project/-/merge_requests/7", Raw: "https://gitlab.com/group/project/-/merge_requests/7"},
+		{Token: "refs", Owner: "owner", Repository: "repo", Issue: "5", Raw: "owner/repo#5"},
+	}, conventionalcommits.ParseReferences("Refs", "https://github.com/org/repo/issues/1#issuecomment-2, #4, https://gitlab.com/group/project/-/merge_requests/7 and owner/repo#5."))
+	assert.Empty(t, conventionalcommits.ParseReferences("Refs", "https://example.com/page#12 and a # b"))
+
+	i := []byte("fix: x\n\nbody with #1\n\nFixes #12 and #13\nRefs: https://github.com/org/repo/pull/2#discussion\nReviewed-by: Z")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, []conventionalcommits.Reference{
+		{Token: "fixes", Issue: "12", Raw: "#12"},
+		{Token: "fixes", Issue: "13", Raw: "#13"},
+		{Token: "refs", Owner: "org", Repository: "repo", Issue: "2", URL: "https://github.com/org/repo/pull/2#discussion", Raw: "https://github.com/org/repo/pull/2#discussion"},
+	}, res.Message.(*conventionalcommits.ConventionalCommit).References())
+
+	// Without the separators, the issue number after the " #" separator is missing
+	c := &conventionalcommits.ConventionalCommit{Footers: map[string][]string{"fixes": {"12 and #13"}}}
+	assert.Equal(t, []conventionalcommits.Reference{{Token: "fixes", Issue: "13", Raw: "#13"}}, c.References())
+}
@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseNote(t *testing.T) {
+	cases := []struct {
+		input string
+		note  string
+		ok    bool
+	}{
+		{"fix: x", "", false},
+		{"fix: x\n\nsome body", "", false},
+		{"fix: x\n\nsome body\n\nRelease-note: Fixed the crash on startup", "Fixed the crash on startup", true},
+		{"fix: x\n\nsome body\n\n```release-note\nFixed the crash\non startup\n```\n\nmore body", "Fixed the crash\non startup", true},
+		{"fix: x\n\nsome body\n\nNOTE:Fixed the crash\non startup\n\nmore body", "Fixed the crash\non startup", true},
+		{"fix: x\n\nNOTE: Fixed the crash", "Fixed the crash", true},
+		{"fix: x\n\nsome body\n\nNOTE: Fixed the crash\nSigned-off-by: A", "Fixed the crash", true},
+		{"fix: x\n\nSee the NOTE: above", "", false},
+		{"chore: x\n\nRelease-note: none", "", true},
+		{"fix: x\n\nNOTE:body\n\nRelease-note: trailer", "trailer", true},
+		{"fix: x\n\n```release-note\nblock\n```\n\nNOTE: trailer", "block", true},
+	}
+
+	for _, tc := range cases {
+		res, err := NewMachine(WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(tc.input))
+		assert.Nil(t, err, "%q", tc.input)
+		note, ok := res.(*conventionalcommits.ConventionalCommit).ReleaseNote()
+		assert.Equal(t, tc.note, note, tc.input)
+		assert.Equal(t, tc.ok, ok, tc.input)
+	}
+}
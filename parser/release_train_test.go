@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseTrain(t *testing.T) {
+	api := conventionalcommits.ChangelogManifest{
+		Version: "v2.0.0",
+		Entries: []conventionalcommits.ChangelogEntry{
+			{ID: "a1", Type: "feat", Scope: "users", Breaking: true, Text: "drop v1"},
+			{ID: "a2", Type: "fix", Text: "crash"},
+			{ID: "a3", Type: "feat", Text: "add endpoint"},
+			{ID: "known-issue-1", Section: "Known issues", Text: "The v1 clients time out"},
+		},
+	}
+	web := conventionalcommits.ChangelogManifest{
+		Version: "v1.4.0",
+		Entries: []conventionalcommits.ChangelogEntry{{ID: "b1", Type: "fix", Text: "typo"}},
+	}
+
+	train := conventionalcommits.NewReleaseTrain("2024.05", map[string]conventionalcommits.ChangelogManifest{
+		"web":  web,
+		"api":  api,
+		"docs": {Version: "v1.0.1"},
+	})
+	assert.Equal(t, conventionalcommits.ReleaseTrain{
+		Version: "2024.05",
+		Releases: []conventionalcommits.RepositoryRelease{
+			{Repository: "api", Version: "v2.0.0", Groups: []conventionalcommits.ChangelogGroup{
+				{Title: "feat", Entries: []conventionalcommits.ChangelogEntry{api.Entries[0], api.Entries[2]}},
+				{Title: "fix", Entries: []conventionalcommits.ChangelogEntry{api.Entries[1]}},
+				{Title: "Known issues", Entries: []conventionalcommits.ChangelogEntry{api.Entries[3]}},
+			}},
+			{Repository: "web", Version: "v1.4.0", Groups: []conventionalcommits.ChangelogGroup{
+				{Title: "fix", Entries: web.Entries},
+			}},
+		},
+	}, train)
+
+	assert.Equal(t, `# 2024.05
+
+## api v2.0.0
+
+### feat
+
+- **users:** drop v1 (breaking)
+- add endpoint
+
+### fix
+
+- crash
+
+### Known issues
+
+- The v1 clients time out
+
+## web v1.4.0
+
+### fix
+
+- typo
+`, train.Markdown())
+
+	assert.Equal(t, "# 2024.05\n", conventionalcommits.NewReleaseTrain("2024.05", nil).Markdown())
+}
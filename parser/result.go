@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"bytes"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// ParseResult parses the input byte array as a Conventional Commit message.
+//
+// Differently from Parse, it tells whether the returned message is complete or partial (best effort mode),
+// and where the parts of the message are in the input.
+func (m *machine) ParseResult(input []byte) conventionalcommits.Result {
+	m.sourceMap = conventionalcommits.NewSourceMap(input)
+	// The errors find their positions with the source map
+	m.lines, m.linesInput = m.sourceMap, input
+	defer func() {
+		m.sourceMap = nil
+	}()
+
+	res := conventionalcommits.NewResult(m.Parse(input))
+	res.SourceMap = *m.sourceMap
+	if c, ok := res.Message.(*conventionalcommits.ConventionalCommit); ok && c.Description != "" {
+		// The description ends the header
+		end := bytes.IndexByte(input, '\n')
+		if end < 0 {
+			end = len(input)
+		}
+		if m.trimTrailingWhitespace {
+			end = len(bytes.TrimRight(input[:end], trailingWhitespace))
+		}
+		res.SourceMap.Description = conventionalcommits.Span{Start: end - len(c.Description), End: end}
+		if c.Body != nil {
+			// The body is a part of the input following the header
+			if i := bytes.Index(input[end:], []byte(*c.Body)); i >= 0 {
+				res.SourceMap.Body = conventionalcommits.Span{Start: end + i, End: end + i + len(*c.Body)}
+			}
+		}
+	}
+	res.Warnings = append(res.Warnings, m.warnings...)
+
+	return res
+}
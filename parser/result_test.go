@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResult(t *testing.T) {
+	cases := []struct {
+		title        string
+		input        string
+		bestEffort   bool
+		completeness conventionalcommits.Completeness
+		errorString  string
+	}{
+		{"full", "fix: x\n\nbody\n\nRefs #1", false, conventionalcommits.CompletenessFull, ""},
+		{"none", "fix x", true, conventionalcommits.CompletenessNone, fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3)},
+		{"none-without-best-effort", "fix: x\nbody", false, conventionalcommits.CompletenessNone, fmt.Sprintf(ErrMissingBlankLineAtBeginning+ColumnPositionTemplate, 7)},
+		{"header-only", "fix: x\nbody", true, conventionalcommits.CompletenessHeaderOnly, fmt.Sprintf(ErrMissingBlankLineAtBeginning+ColumnPositionTemplate, 7)},
+		{"partial", "fix: x\n\nbody\n\nRefs #1\nwrong", true, conventionalcommits.CompletenessPartial, fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "g", 27)},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			opts := []conventionalcommits.MachineOption{}
+			if tc.bestEffort {
+				opts = append(opts, WithBestEffort())
+			}
+			res := newMachine(opts...).ParseResult([]byte(tc.input))
+			assert.Equal(t, tc.completeness, res.Completeness)
+			if tc.errorString == "" {
+				assert.True(t, res.Ok())
+				assert.Nil(t, res.Err())
+				assert.NotNil(t, res.Message)
+			} else {
+				assert.False(t, res.Ok())
+				assert.EqualError(t, res.Err(), tc.errorString)
+			}
+
+			msg, err := NewMachine(opts...).Parse([]byte(tc.input))
+			unpackedMsg, unpackedErr := res.Unpack()
+			assert.Equal(t, msg, unpackedMsg)
+			assert.Equal(t, err, unpackedErr)
+		})
+	}
+}
+
+func TestResultIsBreakingChange(t *testing.T) {
+	p := newMachine()
+	assert.True(t, p.ParseResult([]byte("feat!: drop v1")).IsBreakingChange())
+	assert.True(t, p.ParseResult([]byte("feat: drop v1\n\nBREAKING CHANGE: v1 is gone")).IsBreakingChange())
+	assert.True(t, p.ParseResult([]byte("feat: drop v1\n\nBREAKING-CHANGE: v1 is gone")).IsBreakingChange())
+	assert.False(t, p.ParseResult([]byte("feat: add v2")).IsBreakingChange())
+	assert.False(t, p.ParseResult([]byte("feat drop v1")).IsBreakingChange())
+}
+
+func TestResultRawSegments(t *testing.T) {
+	i := []byte("fix(api):  correct typo \n\nsee  the issue\n\nwith details\n\nReviewed-by: Z\nRefs #133 ")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, "fix(api):  correct typo ", string(res.RawHeader()))
+	assert.Equal(t, "see  the issue\n\nwith details", string(res.RawBody()))
+	assert.Equal(t, "Reviewed-by: Z\nRefs #133 ", string(res.RawFooters()))
+
+	res = newMachine().ParseResult([]byte("fix: x"))
+	assert.Equal(t, "fix: x", string(res.RawHeader()))
+	assert.Nil(t, res.RawBody())
+	assert.Nil(t, res.RawFooters())
+
+	res = conventionalcommits.NewResult(NewMachine().Parse(i))
+	assert.Nil(t, res.RawHeader())
+}
@@ -0,0 +1,29 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewTrailers(t *testing.T) {
+	msg, err := NewMachine().Parse([]byte(`fix: correct typo
+
+Reported-by: Ana <ana@example.com>
+Reviewed-by: Leo Di Donato <leo@example.com>
+acked-by: Bob <bob@example.com>
+Reviewed-by: Z
+Signed-off-by: Leo Di Donato <leo@example.com>`))
+	assert.Nil(t, err)
+	c := msg.(*conventionalcommits.ConventionalCommit)
+
+	assert.Equal(t, conventionalcommits.ReviewTrailers{
+		ReviewedBy: []conventionalcommits.Identity{{Name: "Leo Di Donato", Email: "leo@example.com"}, {Name: "Z"}},
+		AckedBy:    []conventionalcommits.Identity{{Name: "Bob", Email: "bob@example.com"}},
+		TestedBy:   []conventionalcommits.Identity{},
+		ReportedBy: []conventionalcommits.Identity{{Name: "Ana", Email: "ana@example.com"}},
+	}, c.ReviewTrailers())
+	assert.Equal(t, []conventionalcommits.Identity{{Name: "Leo Di Donato", Email: "leo@example.com"}}, c.People("SIGNED-OFF-BY"))
+	assert.Empty(t, c.People("Co-authored-by"))
+}
@@ -0,0 +1,295 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrScopeRequired tells the user that the scope is mandatory.
+	ErrScopeRequired = "expecting a scope, got '%s' character"
+	// ErrTrivialDescription tells the user that the description carries too little information.
+	ErrTrivialDescription = "trivial description '%s'"
+	// ErrHeaderLength tells the user that the first line is longer than the limit.
+	ErrHeaderLength = "header longer than %d characters"
+	// ErrTrailingPeriod tells the user that the description ends with a period.
+	ErrTrailingPeriod = "description ending with a period"
+	// ErrDescriptionCase tells the user that the first letter of the description has the wrong case.
+	ErrDescriptionCase = "expecting the description to start with %s, got '%s' character"
+	// ErrTypeCase tells the user that the type contains uppercase letters.
+	ErrTypeCase = "expecting a lowercase type, got '%s'"
+)
+
+// checkRules checks the parsed header against the rules stricter than the specification.
+//
+// It runs only when the machine parsed the header up to the description.
+func (m *machine) checkRules(output *conventionalCommit) error {
+	if output.descr == "" {
+		return nil
+	}
+	// The type starts at the beginning of the input
+	typeEnd := len(output._type)
+
+	if m.requiredScope && output.scope == "" {
+		m.p = typeEnd
+		if m.data[m.p] == '(' {
+			m.p++
+		}
+		return m.emitErrorOnCurrentCharacter(ErrScopeRequired)
+	}
+
+	if output.scope != "" {
+		if err := m.checkScope(output, typeEnd); err != nil {
+			return err
+		}
+	}
+
+	if m.strictSpec {
+		if err := m.checkSpec(output); err != nil {
+			return err
+		}
+	}
+
+	if m.duplicateFooter != nil {
+		return m.duplicateFooter
+	}
+
+	if m.disallowedFooter != nil {
+		return m.disallowedFooter
+	}
+
+	if m.requiredChangeID {
+		if err := m.checkRequiredChangeID(output); err != nil {
+			return err
+		}
+	}
+
+	if m.trivialDescription != nil {
+		if err := m.checkDescription(output); err != nil {
+			return err
+		}
+	}
+
+	if m.styleWarnings {
+		m.checkTypeCase(output)
+	}
+
+	if m.descriptionCase != conventionalcommits.CaseAny {
+		if err := m.checkDescriptionCase(output); err != nil {
+			return err
+		}
+	}
+
+	if m.noTrailingPeriod {
+		if err := m.checkTrailingPeriod(output); err != nil {
+			return err
+		}
+	}
+
+	if m.maxHeaderLength > 0 {
+		if err := m.checkHeaderLength(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkScope checks the scope, following the type ending at the given position, and splits it when required.
+func (m *machine) checkScope(output *conventionalCommit, typeEnd int) error {
+	// The scope starts after the opening parenthesis
+	scopeStart := typeEnd + 1
+	scopeEnd := scopeStart + len(output.scope)
+
+	if m.scopeNormalization {
+		scopeStart += len(output.scope) - len(strings.TrimLeft(output.scope, scopeWhitespace))
+		scopeEnd -= len(output.scope) - len(strings.TrimRight(output.scope, scopeWhitespace))
+		if scopeStart >= scopeEnd {
+			m.p = typeEnd + 1 + len(output.scope)
+			return m.emitErrorOnCurrentCharacter(ErrScope)
+		}
+		output.scope = string(m.data[scopeStart:scopeEnd])
+	}
+
+	if m.noScopeWhitespace {
+		if i := strings.IndexAny(output.scope, scopeWhitespace); i >= 0 {
+			m.p = scopeStart + i
+			return m.emitErrorOnCurrentCharacter(ErrScope)
+		}
+	}
+
+	if m.multipleScopes {
+		isSeparator := isScopeSeparator
+		if m.scopePath {
+			isSeparator = isCommaSeparator
+		}
+		scopes, err := m.splitScope(scopeStart, scopeEnd, isSeparator)
+		if err != nil {
+			return err
+		}
+		output.scopes = scopes
+	}
+
+	// A path is unambiguous only when there is a single scope
+	if m.scopePath && len(output.scopes) <= 1 {
+		path, err := m.splitScope(scopeStart, scopeEnd, isPathSeparator)
+		if err != nil {
+			return err
+		}
+		output.scopePath = path
+	}
+
+	return nil
+}
+
+// scopeWhitespace contains the whitespace characters trimmed from, or rejected in, the scope.
+const scopeWhitespace = " \t"
+
+// isScopeSeparator tells whether the given character separates multiple scopes.
+func isScopeSeparator(c byte) bool {
+	return c == ',' || c == '/'
+}
+
+// isCommaSeparator tells whether the given character is a comma.
+func isCommaSeparator(c byte) bool {
+	return c == ','
+}
+
+// isPathSeparator tells whether the given character separates the segments of a scope path.
+func isPathSeparator(c byte) bool {
+	return c == '/'
+}
+
+// splitScope splits the scope between the given positions into trimmed items.
+//
+// It emits an error on the character following an empty item.
+func (m *machine) splitScope(start, end int, isSeparator func(byte) bool) ([]string, error) {
+	items := []string{}
+	from := start
+	for i := start; i <= end; i++ {
+		if i < end && !isSeparator(m.data[i]) {
+			continue
+		}
+		s := strings.TrimSpace(string(m.data[from:i]))
+		if s == "" {
+			m.p = i
+			return nil, m.emitErrorOnCurrentCharacter(ErrScope)
+		}
+		items = append(items, s)
+		from = i + 1
+	}
+
+	return items, nil
+}
+
+// checkDescription checks whether the description is trivial.
+//
+// Unless the rule says otherwise, a trivial description is a warning rather than an error.
+func (m *machine) checkDescription(output *conventionalCommit) error {
+	if !m.trivialDescription.IsTrivial(output.descr) {
+		return nil
+	}
+	// The description ends the first line
+	end := bytes.IndexByte(m.data, '\n')
+	if end < 0 {
+		end = m.pe
+	}
+	start := end - len(output.descr)
+	err := m.emitErrorAt(conventionalcommits.Span{Start: start, End: end}, ErrTrivialDescription, output.descr, start)
+	if m.trivialDescription.Error {
+		return err
+	}
+	m.warnings = append(m.warnings, err)
+
+	return nil
+}
+
+// checkHeaderLength checks whether the first line is longer than the limit.
+//
+// A too long first line is a style issue.
+func (m *machine) checkHeaderLength() error {
+	end := bytes.IndexByte(m.data, '\n')
+	if end < 0 {
+		end = m.pe
+	}
+	start, count := 0, 0
+	// The lenient amendments of the first line do not count
+	for start < end && count < m.maxHeaderLength+m.headerShift {
+		_, size := utf8.DecodeRune(m.data[start:end])
+		start += size
+		count++
+	}
+	if start == end {
+		return nil
+	}
+	err := m.emitErrorAt(conventionalcommits.Span{Start: start, End: end}, ErrHeaderLength, m.maxHeaderLength, start)
+	return m.styleIssue(err)
+}
+
+// checkTrailingPeriod checks whether the description ends with a period, ignoring the trailing whitespace.
+//
+// The trailing period is a style issue.
+func (m *machine) checkTrailingPeriod(output *conventionalCommit) error {
+	descr := strings.TrimRight(output.descr, " \t")
+	if !strings.HasSuffix(descr, ".") {
+		return nil
+	}
+	// The description ends the first line
+	end := bytes.IndexByte(m.data, '\n')
+	if end < 0 {
+		end = m.pe
+	}
+	at := end - len(output.descr) + len(descr) - 1
+	err := m.emitErrorAt(conventionalcommits.Span{Start: at, End: at + 1}, ErrTrailingPeriod, at)
+	return m.styleIssue(err)
+}
+
+// checkDescriptionCase checks the case of the first character of the description, when it is a letter.
+//
+// The wrong case is a style issue.
+func (m *machine) checkDescriptionCase(output *conventionalCommit) error {
+	first, size := utf8.DecodeRuneInString(output.descr)
+	expected := "an uppercase letter"
+	valid := unicode.IsUpper(first)
+	if m.descriptionCase == conventionalcommits.CaseLowerFirst {
+		expected = "a lowercase letter"
+		valid = unicode.IsLower(first)
+	}
+	if valid || !unicode.IsLetter(first) {
+		return nil
+	}
+	// The description ends the first line
+	end := bytes.IndexByte(m.data, '\n')
+	if end < 0 {
+		end = m.pe
+	}
+	start := end - len(output.descr)
+
+	err := m.emitErrorAt(conventionalcommits.Span{Start: start, End: start + size}, ErrDescriptionCase, expected, string(first), start)
+	return m.styleIssue(err)
+}
+
+// styleIssue returns the given error about a style issue, or records it as a warning with style warnings or in best effort mode.
+//
+// It is the only place demoting the style issues, so that enabling both modes reports them once, as warnings.
+func (m *machine) styleIssue(err error) error {
+	if !m.styleWarnings && !m.bestEffort {
+		return err
+	}
+	m.warnings = append(m.warnings, err)
+
+	return nil
+}
+
+// checkTypeCase warns when the type contains uppercase letters, which the specification allows.
+func (m *machine) checkTypeCase(output *conventionalCommit) {
+	if bytes.Equal(output._type, bytes.ToLower(output._type)) {
+		return
+	}
+	// The type starts at the beginning of the input
+	m.warnings = append(m.warnings, m.emitErrorAt(conventionalcommits.Span{Start: 0, End: len(output._type)}, ErrTypeCase, string(output._type), 0))
+}
@@ -0,0 +1,680 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+type ruleTestCase struct {
+	title        string
+	input        string
+	errorString  string
+	partialValue conventionalcommits.Message
+}
+
+func ruleRunner(t *testing.T, cases []ruleTestCase, machineOpts ...conventionalcommits.MachineOption) {
+	t.Helper()
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			message, messageErr := NewMachine(machineOpts...).Parse([]byte(tc.input))
+			partial, partialErr := NewMachine(append(machineOpts, WithBestEffort())...).Parse([]byte(tc.input))
+
+			if tc.errorString == "" {
+				assert.Nil(t, messageErr)
+				assert.Nil(t, partialErr)
+				assert.NotNil(t, message)
+				assert.Equal(t, message, partial)
+			} else {
+				assert.Nil(t, message)
+				assert.EqualError(t, messageErr, tc.errorString)
+				assert.EqualError(t, partialErr, tc.errorString)
+			}
+			if tc.partialValue != nil {
+				assert.Equal(t, tc.partialValue, partial)
+			}
+		})
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"valid",
+			"feat(core): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Scope: cctesting.StringAddress("core"), Description: "message"},
+		},
+		{
+			"valid-breaking",
+			"feat(core)!: message",
+			"",
+			nil,
+		},
+		{
+			"missing",
+			"feat: message",
+			fmt.Sprintf(ErrScopeRequired+ColumnPositionTemplate, ":", 4),
+			&conventionalcommits.ConventionalCommit{Type: "feat", Description: "message"},
+		},
+		{
+			"missing-breaking",
+			"fix!: message",
+			fmt.Sprintf(ErrScopeRequired+ColumnPositionTemplate, "!", 3),
+			nil,
+		},
+		{
+			"empty",
+			"fix(): message",
+			fmt.Sprintf(ErrScopeRequired+ColumnPositionTemplate, ")", 4),
+			nil,
+		},
+		{
+			"missing-before-other-errors",
+			"fix: message\nmissing blank line",
+			fmt.Sprintf(ErrScopeRequired+ColumnPositionTemplate, ":", 3),
+			nil,
+		},
+		{
+			"other-errors-before",
+			"fix",
+			fmt.Sprintf(ErrEarly+ColumnPositionTemplate, "x", 2),
+			nil,
+		},
+	}, WithRequiredScope())
+}
+
+func TestMultipleScopes(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"comma-separated",
+			"fix(api,CLI): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api,cli"), MultipleScopes: []string{"api", "cli"}, Description: "message"},
+		},
+		{
+			"slash-separated-with-spaces",
+			"fix(api / cli, docs)!: message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api / cli, docs"), MultipleScopes: []string{"api", "cli", "docs"}, Exclamation: true, Description: "message"},
+		},
+		{
+			"single",
+			"fix(api): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api"), MultipleScopes: []string{"api"}, Description: "message"},
+		},
+		{
+			"none",
+			"fix: message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"empty-in-the-middle",
+			"fix(api,,cli): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, ",", 8),
+			nil,
+		},
+		{
+			"empty-at-the-end",
+			"fix(api, ): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, ")", 9),
+			nil,
+		},
+	}, WithMultipleScopes())
+
+	m, _ := NewMachine().Parse([]byte("fix(api,cli): message"))
+	assert.Equal(t, []string{"api,cli"}, m.(conventionalcommits.Scoper).Scopes())
+	m, _ = NewMachine(WithMultipleScopes()).Parse([]byte("fix(api,cli): message"))
+	assert.Equal(t, []string{"api", "cli"}, m.(conventionalcommits.Scoper).Scopes())
+	m, _ = NewMachine().Parse([]byte("fix: message"))
+	assert.Nil(t, m.(conventionalcommits.Scoper).Scopes())
+}
+
+func TestScopePath(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"nested",
+			"feat(pkg/Parser/footer): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Scope: cctesting.StringAddress("pkg/parser/footer"), ScopePath: []string{"pkg", "parser", "footer"}, Description: "message"},
+		},
+		{
+			"single",
+			"feat(pkg): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Scope: cctesting.StringAddress("pkg"), ScopePath: []string{"pkg"}, Description: "message"},
+		},
+		{
+			"none",
+			"feat: message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Description: "message"},
+		},
+		{
+			"empty-segment",
+			"feat(pkg//footer): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, "/", 9),
+			nil,
+		},
+	}, WithScopePath())
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"single-with-multiple-scopes",
+			"feat(pkg/parser): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Scope: cctesting.StringAddress("pkg/parser"), MultipleScopes: []string{"pkg/parser"}, ScopePath: []string{"pkg", "parser"}, Description: "message"},
+		},
+		{
+			"many-with-multiple-scopes",
+			"feat(pkg/parser, cli): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "feat", Scope: cctesting.StringAddress("pkg/parser, cli"), MultipleScopes: []string{"pkg/parser", "cli"}, Description: "message"},
+		},
+	}, WithScopePath(), WithMultipleScopes())
+}
+
+func TestScopeNormalization(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"trailing-space",
+			"Fix(Core ): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("core"), Description: "message"},
+		},
+		{
+			"spaces-around",
+			"fix(  api cli ): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api cli"), Description: "message"},
+		},
+		{
+			"blank",
+			"fix(  ): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, ")", 6),
+			nil,
+		},
+	}, WithScopeNormalization())
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"trailing-space",
+			"fix(core ): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, " ", 8),
+			nil,
+		},
+		{
+			"inner-space",
+			"fix(api cli): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, " ", 7),
+			nil,
+		},
+	}, WithNoScopeWhitespace())
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"trailing-space",
+			"fix( core ): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("core"), MultipleScopes: []string{"core"}, Description: "message"},
+		},
+		{
+			"inner-space",
+			"fix( api cli ): message",
+			fmt.Sprintf(ErrScope+ColumnPositionTemplate, " ", 8),
+			nil,
+		},
+		{
+			"multiple-scopes",
+			"fix( api,cli ): message",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api,cli"), MultipleScopes: []string{"api", "cli"}, Description: "message"},
+		},
+	}, WithScopeNormalization(), WithNoScopeWhitespace(), WithMultipleScopes())
+}
+
+func TestTrivialDescriptionRule(t *testing.T) {
+	p := newMachine(WithTrivialDescriptionRule(conventionalcommits.DefaultTrivialDescriptionRule))
+
+	res := p.ParseResult([]byte("fix: Fix stuff.\n\nbody"))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrTrivialDescription+ColumnPositionTemplate, "Fix stuff.", 5))
+	d := res.Diagnostics()
+	assert.Equal(t, "trivial-description", d[0].Code)
+	assert.Equal(t, conventionalcommits.SeverityWarning, d[0].Severity)
+	assert.Equal(t, conventionalcommits.Span{Start: 5, End: 15}, d[0].Range)
+
+	res = p.ParseResult([]byte("feat(api): wip"))
+	assert.Len(t, res.Warnings, 1)
+
+	res = p.ParseResult([]byte("feat: logging"))
+	assert.Len(t, res.Warnings, 1)
+
+	res = p.ParseResult([]byte("fix: correct the typo"))
+	assert.Empty(t, res.Warnings)
+
+	// Warnings don't make Parse fail
+	m, err := p.Parse([]byte("fix: update"))
+	assert.Nil(t, err)
+	assert.NotNil(t, m)
+
+	strict := conventionalcommits.TrivialDescriptionRule{Denylist: []string{"update"}, MinLength: 8, Error: true}
+	ruleRunner(t, []ruleTestCase{
+		{
+			"denied",
+			"fix: Update",
+			fmt.Sprintf(ErrTrivialDescription+ColumnPositionTemplate, "Update", 5),
+			nil,
+		},
+		{
+			"too-short",
+			"fix: typo",
+			fmt.Sprintf(ErrTrivialDescription+ColumnPositionTemplate, "typo", 5),
+			nil,
+		},
+		{
+			"fine",
+			"fix: correct typo",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "correct typo"},
+		},
+	}, WithTrivialDescriptionRule(strict))
+}
+
+func TestStrictSpec(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"compliant",
+			"fix(api)!: message\n\nbody\n\nBREAKING CHANGE: drop v1\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Scope: cctesting.StringAddress("api"), Exclamation: true, Description: "message", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"breaking-change": {"drop v1"}, "refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "breaking-change", Separator: ": ", Value: "drop v1"}, {Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+		{
+			"two-spaces-after-colon",
+			"fix:  message",
+			fmt.Sprintf(ErrSpecDescriptionSpace+ColumnPositionTemplate, " ", 5),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"two-blank-lines-before-body",
+			"fix: message\n\n\nbody",
+			fmt.Sprintf(ErrSpecBodyBlankLine+ColumnPositionTemplate, 14),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message", Body: cctesting.StringAddress("\nbody")},
+		},
+		{
+			"trailing-blank-lines",
+			"fix: message\n\n\n",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message"},
+		},
+		{
+			"two-blank-lines-before-footers",
+			"fix: message\n\nbody\n\n\nRefs: #1",
+			fmt.Sprintf(ErrSpecFooterBlankLine+ColumnPositionTemplate, 20),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+		{
+			"mixed-case-breaking-change",
+			"fix: message\n\nRefs: #1\nBreaking-Change: drop v1",
+			fmt.Sprintf(ErrSpecBreakingChangeCase+ColumnPositionTemplate, "Breaking-Change", 23),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message", Footers: map[string][]string{"breaking-change": {"drop v1"}, "refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}, {Token: "breaking-change", Separator: ": ", Value: "drop v1"}}},
+		},
+		{
+			"lowercase-breaking-change",
+			"fix: message\n\nbreaking change: drop v1",
+			fmt.Sprintf(ErrSpecBreakingChangeCase+ColumnPositionTemplate, "breaking change", 14),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message", Body: cctesting.StringAddress("breaking change: drop v1")},
+		},
+		{
+			"lowercase-breaking-change-after-body",
+			"fix: message\n\nbody\n\nRefs: #1\nBreaking Change #2",
+			fmt.Sprintf(ErrSpecBreakingChangeCase+ColumnPositionTemplate, "Breaking Change", 29),
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "message", Body: cctesting.StringAddress("body"), Footers: map[string][]string{"refs": {"#1"}}, OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}}},
+		},
+	}, WithStrictSpec())
+
+	d := newMachine(WithStrictSpec()).ParseResult([]byte("fix: message\n\nbreaking-change: drop v1")).Diagnostics()
+	assert.Equal(t, "spec-item-15", d[0].Code)
+	assert.Equal(t, conventionalcommits.Span{Start: 14, End: 29}, d[0].Range)
+}
+
+func TestMaxHeaderLength(t *testing.T) {
+	m, err := NewMachine(WithMaxHeaderLength(12)).Parse([]byte("fix: a long description\n\nbody"))
+	assert.Nil(t, m)
+	assert.EqualError(t, err, fmt.Sprintf(ErrHeaderLength+ColumnPositionTemplate, 12, 12))
+	d := conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError)
+	assert.Equal(t, "header-length", d.Code)
+	assert.Equal(t, conventionalcommits.Span{Start: 12, End: 23}, d.Range)
+
+	// Characters rather than bytes
+	_, err = NewMachine(WithMaxHeaderLength(12)).Parse([]byte("fix: déjà vu"))
+	assert.Nil(t, err)
+	_, err = NewMachine(WithMaxHeaderLength(11)).Parse([]byte("fix: déjà vu"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrHeaderLength+ColumnPositionTemplate, 11, 13))
+
+	// The first line as written, rather than as amended by the lenient options
+	_, err = NewMachine(WithLenientColonSpace(), WithMaxHeaderLength(8)).Parse([]byte("fix:abcd"))
+	assert.Nil(t, err)
+	_, err = NewMachine(WithLenientColonSpace(), WithMaxHeaderLength(8)).Parse([]byte("fix:abcde"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrHeaderLength+ColumnPositionTemplate, 8, 8))
+	_, err = NewMachine(WithSkipBOM(), WithMaxHeaderLength(8)).Parse([]byte("\xEF\xBB\xBFfix: abc"))
+	assert.Nil(t, err)
+
+	res := newMachine(WithMaxHeaderLength(12), WithBestEffort()).ParseResult([]byte("fix: a long description"))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrHeaderLength+ColumnPositionTemplate, 12, 12))
+}
+
+func TestNoTrailingPeriod(t *testing.T) {
+	p := NewMachine(WithNoTrailingPeriod())
+
+	_, err := p.Parse([]byte("fix: correct typo.\n\nbody."))
+	assert.EqualError(t, err, fmt.Sprintf(ErrTrailingPeriod+ColumnPositionTemplate, 17))
+	d := conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError)
+	assert.Equal(t, "trailing-period", d.Code)
+	assert.Equal(t, conventionalcommits.Span{Start: 17, End: 18}, d.Range)
+
+	_, err = p.Parse([]byte("feat(api): add endpoint..."))
+	assert.EqualError(t, err, fmt.Sprintf(ErrTrailingPeriod+ColumnPositionTemplate, 25))
+
+	m, err := p.Parse([]byte("fix: support v1.2 files"))
+	assert.Nil(t, err)
+	assert.Equal(t, "support v1.2 files", m.(*conventionalcommits.ConventionalCommit).Description)
+
+	res := newMachine(WithNoTrailingPeriod(), WithBestEffort()).ParseResult([]byte("fix: correct typo."))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrTrailingPeriod+ColumnPositionTemplate, 17))
+}
+
+func TestDescriptionCase(t *testing.T) {
+	ruleRunner(t, []ruleTestCase{
+		{
+			"lowercase",
+			"fix: correct typo",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "correct typo"},
+		},
+		{
+			"not-a-letter",
+			"fix: `Parse` panics",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "`Parse` panics"},
+		},
+	}, WithDescriptionCase(conventionalcommits.CaseLowerFirst))
+
+	p := newMachine(WithDescriptionCase(conventionalcommits.CaseLowerFirst))
+	_, err := p.Parse([]byte("fix(api): Correct typo"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrDescriptionCase+ColumnPositionTemplate, "a lowercase letter", "C", 10))
+	_, err = p.Parse([]byte("fix: Évite le crash"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrDescriptionCase+ColumnPositionTemplate, "a lowercase letter", "É", 5))
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"uppercase",
+			"fix: Correct typo",
+			"",
+			&conventionalcommits.ConventionalCommit{Type: "fix", Description: "Correct typo"},
+		},
+	}, WithDescriptionCase(conventionalcommits.CaseSentence))
+
+	_, err = NewMachine(WithDescriptionCase(conventionalcommits.CaseSentence)).Parse([]byte("fix: correct typo"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrDescriptionCase+ColumnPositionTemplate, "an uppercase letter", "c", 5))
+
+	// Like the other style issues, the wrong case is a warning in best effort mode
+	res := newMachine(WithDescriptionCase(conventionalcommits.CaseSentence), WithBestEffort()).ParseResult([]byte("fix: correct typo"))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrDescriptionCase+ColumnPositionTemplate, "an uppercase letter", "c", 5))
+
+	assert.Equal(t, "sentence-case", conventionalcommits.CaseSentence.String())
+}
+
+func TestStyleWarnings(t *testing.T) {
+	p := newMachine(
+		WithTypes(conventionalcommits.TypesConventional),
+		WithMaxHeaderLength(16),
+		WithNoTrailingPeriod(),
+		WithDescriptionCase(conventionalcommits.CaseLowerFirst),
+		WithStyleWarnings(),
+	)
+
+	res := p.ParseResult([]byte("FIX: Correct typo."))
+	assert.True(t, res.Ok())
+	assert.Equal(t, "fix", res.Message.(*conventionalcommits.ConventionalCommit).Type)
+	codes := []string{}
+	for _, d := range res.Diagnostics() {
+		assert.Equal(t, conventionalcommits.SeverityWarning, d.Severity)
+		codes = append(codes, d.Code)
+	}
+	assert.Equal(t, []string{"type-case", "description-case", "trailing-period", "header-length"}, codes)
+	assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrTypeCase+ColumnPositionTemplate, "FIX", 0))
+	assert.Equal(t, "write 'fix' instead", res.Diagnostics()[0].Suggestion)
+
+	// Together with the best effort mode, the style issues are reported once
+	res = newMachine(
+		WithTypes(conventionalcommits.TypesConventional),
+		WithMaxHeaderLength(16),
+		WithNoTrailingPeriod(),
+		WithDescriptionCase(conventionalcommits.CaseLowerFirst),
+		WithStyleWarnings(),
+		WithBestEffort(),
+	).ParseResult([]byte("FIX: Correct typo."))
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 4)
+
+	// Structural failures remain errors
+	res = p.ParseResult([]byte("FIX Correct typo."))
+	assert.False(t, res.Ok())
+	assert.EqualError(t, res.Err(), fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3))
+	assert.Empty(t, res.Warnings)
+
+	// Without style warnings, uppercase types are fine
+	res = newMachine(WithTypes(conventionalcommits.TypesConventional)).ParseResult([]byte("FIX: correct typo"))
+	assert.True(t, res.Ok())
+	assert.Empty(t, res.Warnings)
+}
+
+func TestDuplicateFooterPolicy(t *testing.T) {
+	i := "fix: x\n\nChange-Id: I1\nRefs: #1\nchange-id: I2"
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"append",
+			i,
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"change-id": {"I1", "I2"}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "change-id", Separator: ": ", Value: "I1"}, {Token: "refs", Separator: ": ", Value: "#1"}, {Token: "change-id", Separator: ": ", Value: "I2"}},
+			},
+		},
+	}, WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersAppend))
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"error",
+			i,
+			fmt.Sprintf(ErrDuplicateFooter+ColumnPositionTemplate, "change-id", 31),
+			nil,
+		},
+		{
+			"distinct",
+			"fix: x\n\nChange-Id: I1\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"change-id": {"I1"}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "change-id", Separator: ": ", Value: "I1"}, {Token: "refs", Separator: ": ", Value: "#1"}},
+			},
+		},
+	}, WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersError))
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"first-wins",
+			i,
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"change-id": {"I1"}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "change-id", Separator: ": ", Value: "I1"}, {Token: "refs", Separator: ": ", Value: "#1"}},
+			},
+		},
+	}, WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersFirstWins))
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"last-wins",
+			i,
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"change-id": {"I2"}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}, {Token: "change-id", Separator: ": ", Value: "I2"}},
+			},
+		},
+	}, WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins))
+
+	res := newMachine(WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins)).ParseResult([]byte(i))
+	if assert.Len(t, res.SourceMap.Footers, 2) {
+		assert.Equal(t, "Refs: #1", string(res.SourceMap.Footers[0].Span.Text([]byte(i))))
+		assert.Equal(t, "change-id: I2", string(res.SourceMap.Footers[1].Span.Text([]byte(i))))
+	}
+
+	res = newMachine(WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersError)).ParseResult([]byte(i))
+	if assert.Len(t, res.Diagnostics(), 1) {
+		assert.Equal(t, "duplicate-footer", res.Diagnostics()[0].Code)
+	}
+	assert.Equal(t, "first-wins", conventionalcommits.DuplicateFootersFirstWins.String())
+}
+
+func TestRequiredChangeID(t *testing.T) {
+	id := "I8473b95934b5732ac55d26311a706c9c2bde9940"
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"valid",
+			"fix: x\n\nChange-Id: " + id + "\nRefs: #1",
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"change-id": {id}, "refs": {"#1"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "change-id", Separator: ": ", Value: id}, {Token: "refs", Separator: ": ", Value: "#1"}},
+			},
+		},
+		{
+			"missing",
+			"fix: x\n\nRefs: #1",
+			fmt.Sprintf(ErrChangeIDRequired+ColumnPositionTemplate, 16),
+			nil,
+		},
+		{
+			"header only",
+			"fix: x",
+			fmt.Sprintf(ErrChangeIDRequired+ColumnPositionTemplate, 6),
+			nil,
+		},
+		{
+			"invalid",
+			"fix: x\n\nChange-Id: I1a2b",
+			fmt.Sprintf(ErrChangeID+ColumnPositionTemplate, "I1a2b", 19),
+			nil,
+		},
+		{
+			"uppercase",
+			"fix: x\n\nChange-Id: " + strings.ToUpper(id),
+			fmt.Sprintf(ErrChangeID+ColumnPositionTemplate, strings.ToUpper(id), 19),
+			nil,
+		},
+		{
+			"invalid besides a valid one",
+			"fix: x\n\nChange-Id: " + id + "\nChange-Id: I1a2b",
+			fmt.Sprintf(ErrChangeID+ColumnPositionTemplate, "I1a2b", 72),
+			nil,
+		},
+	}, WithRequiredChangeID())
+
+	// The values are checked once trimmed, and once the duplicate footer policy dropped some
+	_, err := NewMachine(WithRequiredChangeID(), WithTrimTrailingWhitespace()).Parse([]byte("fix: x\n\nChange-Id: " + id + " \nRefs: #1"))
+	assert.Nil(t, err)
+	_, err = NewMachine(WithRequiredChangeID()).Parse([]byte("fix: x\n\nChange-Id: " + id + " \nRefs: #1"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrChangeID+ColumnPositionTemplate, id+" ", 19))
+	_, err = NewMachine(WithRequiredChangeID(), WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersFirstWins)).Parse([]byte("fix: x\n\nChange-Id: " + id + "\nChange-Id: Ibad"))
+	assert.Nil(t, err)
+	_, err = NewMachine(WithRequiredChangeID(), WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins)).Parse([]byte("fix: x\n\nChange-Id: Ibad\nChange-Id: " + id))
+	assert.Nil(t, err)
+	_, err = NewMachine(WithRequiredChangeID(), WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins)).Parse([]byte("fix: x\n\nChange-Id: " + id + "\nChange-Id: Ibad"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrChangeID+ColumnPositionTemplate, "Ibad", 72))
+
+	msg, err := NewMachine().Parse([]byte("fix: x\n\nChange-Id: I1a2b\nChange-Id: " + id))
+	assert.Nil(t, err)
+	got, ok := msg.(*conventionalcommits.ConventionalCommit).ChangeID()
+	assert.True(t, ok)
+	assert.Equal(t, id, got)
+
+	msg, err = NewMachine().Parse([]byte("fix: x\n\nChange-Id: I1a2b"))
+	assert.Nil(t, err)
+	_, ok = msg.(*conventionalcommits.ConventionalCommit).ChangeID()
+	assert.False(t, ok)
+}
+
+func TestAllowedFooters(t *testing.T) {
+	allowed := WithAllowedFooters([]string{"BREAKING CHANGE", "Refs", "Signed-off-by"})
+
+	ruleRunner(t, []ruleTestCase{
+		{
+			"allowed",
+			"fix: x\n\nREFS: #1\nBREAKING-CHANGE: drop v1\nsigned-off-by: Leo",
+			"",
+			&conventionalcommits.ConventionalCommit{
+				Type:           "fix",
+				Description:    "x",
+				Footers:        map[string][]string{"refs": {"#1"}, "breaking-change": {"drop v1"}, "signed-off-by": {"Leo"}},
+				OrderedFooters: []conventionalcommits.Footer{{Token: "refs", Separator: ": ", Value: "#1"}, {Token: "breaking-change", Separator: ": ", Value: "drop v1"}, {Token: "signed-off-by", Separator: ": ", Value: "Leo"}},
+			},
+		},
+	}, allowed)
+
+	_, err := NewMachine(allowed).Parse([]byte("fix: x\n\nRefs: #1\nFixes: #2\nAcked-by: Z"))
+	assert.EqualError(t, err, fmt.Sprintf(ErrFooterNotAllowed+ColumnPositionTemplate, "Fixes", 17))
+	assert.IsType(t, &TrailerError{}, err)
+
+	// In best effort mode, the footer trailers not allowed are warnings
+	res := newMachine(allowed, WithBestEffort()).ParseResult([]byte("fix: x\n\nRefs: #1\nFixes: #2\nAcked-by: Z"))
+	assert.Nil(t, res.Err())
+	assert.Equal(t, []string{"#2"}, res.Message.(conventionalcommits.FooterReader).Footer("Fixes"))
+	if assert.Len(t, res.Warnings, 2) {
+		assert.EqualError(t, res.Warnings[0], fmt.Sprintf(ErrFooterNotAllowed+ColumnPositionTemplate, "Fixes", 17))
+		assert.EqualError(t, res.Warnings[1], fmt.Sprintf(ErrFooterNotAllowed+ColumnPositionTemplate, "Acked-by", 27))
+		assert.Equal(t, "use one of 'BREAKING CHANGE', 'Refs', 'Signed-off-by' instead", res.Diagnostics()[0].Suggestion)
+	}
+
+	// No list allows any footer trailer
+	_, err = NewMachine(WithAllowedFooters(nil)).Parse([]byte("fix: x\n\nFixes: #2"))
+	assert.Nil(t, err)
+
+	// The parser keeps a copy of the tokens, and hands out copies of them
+	tokens := []string{"Refs"}
+	p := NewParser(WithAllowedFooters(tokens))
+	tokens[0] = "Fixes"
+	p.Config().AllowedFooters()[0] = "Fixes"
+	_, err = p.Parse([]byte("fix: x\n\nRefs: #1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Refs"}, p.Config().AllowedFooters())
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func release(t *testing.T, name string, inputs ...string) conventionalcommits.Release {
+	t.Helper()
+	r := conventionalcommits.Release{Name: name}
+	for _, i := range inputs {
+		m, err := NewMachine(WithMultipleScopes()).Parse([]byte(i))
+		assert.Nil(t, err)
+		r.Messages = append(r.Messages, m)
+	}
+	return r
+}
+
+func TestScopeReport(t *testing.T) {
+	releases := []conventionalcommits.Release{
+		release(t, "v1.0.0", "feat(docs): x", "fix(legacy): x", "fix(api): x"),
+		release(t, "v1.1.0", "feat(api,cli): x"),
+		release(t, "v1.2.0", "fix(api): x", "fix: x"),
+	}
+	allowlist := []string{"api", "cli", "docs", "legacy", "parser"}
+
+	assert.Equal(t, conventionalcommits.ScopeReport{
+		Unused:   []string{"docs", "legacy", "parser"},
+		UsedOnce: []string{"cli", "docs", "legacy"},
+	}, conventionalcommits.NewScopeReport(releases, allowlist, 2))
+
+	assert.Equal(t, []string{"parser"}, conventionalcommits.NewScopeReport(releases, allowlist, 0).Unused)
+}
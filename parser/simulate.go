@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Simulation tells how a proposed configuration would change the outcome of parsing a corpus of commit messages.
+//
+// The indexes refer to the commit messages in the corpus.
+type Simulation struct {
+	Total        int
+	Passing      int
+	NewlyPassing []int
+	NewlyFailing []int
+}
+
+// Simulate parses the given corpus of commit messages with the current and the proposed configurations,
+// so that teams can tune their policies before enforcing them.
+func Simulate(corpus [][]byte, current, proposed []conventionalcommits.MachineOption) Simulation {
+	before, after := NewParser(current...), NewParser(proposed...)
+	s := Simulation{Total: len(corpus), NewlyPassing: []int{}, NewlyFailing: []int{}}
+	for i, input := range corpus {
+		_, errBefore := before.Parse(input)
+		_, errAfter := after.Parse(input)
+		if errAfter == nil {
+			s.Passing++
+		}
+		switch {
+		case errBefore != nil && errAfter == nil:
+			s.NewlyPassing = append(s.NewlyPassing, i)
+		case errBefore == nil && errAfter != nil:
+			s.NewlyFailing = append(s.NewlyFailing, i)
+		}
+	}
+
+	return s
+}
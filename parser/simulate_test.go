@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulate(t *testing.T) {
+	corpus := [][]byte{
+		[]byte("fix: x"),
+		[]byte("fix:typo"),
+		[]byte("feat(api): add endpoint"),
+		[]byte("docs: readme"),
+		[]byte("chore(deps): bump"),
+	}
+	current := []conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesConventional)}
+	proposed := []conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesConventional), WithRequiredScope(), WithLenientColonSpace()}
+
+	assert.Equal(t, Simulation{
+		Total:        5,
+		Passing:      2,
+		NewlyPassing: []int{},
+		NewlyFailing: []int{0, 3},
+	}, Simulate(corpus, current, proposed))
+
+	assert.Equal(t, Simulation{
+		Total:        5,
+		Passing:      5,
+		NewlyPassing: []int{1},
+		NewlyFailing: []int{},
+	}, Simulate(corpus, current, []conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesConventional), WithLenientColonSpace()}))
+}
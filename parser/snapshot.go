@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Snapshot records the outcome of parsing a corpus of commit message files, keyed by their paths.
+//
+// Comparing the snapshots of two versions of this library tells the consumers how their behavior differs before upgrading.
+type Snapshot map[string]Record
+
+// NewSnapshot parses every file of fsys matching the glob pattern, like ParseFS does, and records the outcomes.
+//
+// Use it with the corpus of the testing package (eg., NewSnapshot(cctesting.Corpus(), "*/*.txt")).
+func NewSnapshot(fsys fs.FS, pattern string, options ...conventionalcommits.MachineOption) (Snapshot, error) {
+	paths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	m := newMachine(options...)
+	s := make(Snapshot, len(paths))
+	for _, p := range paths {
+		input, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p, err)
+		}
+		s[p] = NewRecord(0, m.ParseResult(input))
+	}
+
+	return s, nil
+}
+
+// WriteTo writes the snapshot in JSON format, sorted by path.
+func (s Snapshot) WriteTo(w io.Writer) (int64, error) {
+	out, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(out, '\n'))
+	return int64(n), err
+}
+
+// ReadSnapshot reads a snapshot written by WriteTo.
+func ReadSnapshot(r io.Reader) (Snapshot, error) {
+	s := Snapshot{}
+	err := json.NewDecoder(r).Decode(&s)
+	return s, err
+}
+
+// SnapshotChange is a commit message file whose outcome changed between two snapshots.
+//
+// Before is nil when the file is new, After when the file is gone.
+type SnapshotChange struct {
+	Path   string
+	Before *Record
+	After  *Record
+}
+
+// CompareSnapshots returns the commit message files whose outcome changed from the previous snapshot to the current one,
+// sorted by path.
+//
+// It is empty when the behavior did not change.
+func CompareSnapshots(previous, current Snapshot) []SnapshotChange {
+	paths := []string{}
+	for p := range previous {
+		paths = append(paths, p)
+	}
+	for p := range current {
+		if _, ok := previous[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := []SnapshotChange{}
+	for _, p := range paths {
+		before, hadBefore := previous[p]
+		after, hasAfter := current[p]
+		if hadBefore && hasAfter && sameRecord(before, after) {
+			continue
+		}
+		c := SnapshotChange{Path: p}
+		if hadBefore {
+			c.Before = &before
+		}
+		if hasAfter {
+			c.After = &after
+		}
+		changes = append(changes, c)
+	}
+
+	return changes
+}
+
+// sameRecord tells whether the given records have the same JSON representation,
+// so that the records read from a snapshot compare equal to the fresh ones.
+func sameRecord(a, b Record) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return string(x) == string(y)
+}
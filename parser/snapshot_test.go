@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"bytes"
+	"testing"
+	"testing/fstest"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	cctesting "github.com/reviewpad/go-conventionalcommits/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	s, err := NewSnapshot(cctesting.Corpus(), "*/*.txt", WithTypes(conventionalcommits.TypesConventional))
+	assert.Nil(t, err)
+	assert.Len(t, s, len(cctesting.Examples()))
+	assert.True(t, s["valid/valid-with-scope.txt"].Ok)
+
+	// A round trip keeps the behavior unchanged
+	var buf bytes.Buffer
+	_, err = s.WriteTo(&buf)
+	assert.Nil(t, err)
+	read, err := ReadSnapshot(&buf)
+	assert.Nil(t, err)
+	assert.Empty(t, CompareSnapshots(read, s))
+
+	// Another configuration changes the behavior
+	strict, err := NewSnapshot(cctesting.Corpus(), "*/*.txt", WithTypes(conventionalcommits.TypesConventional), WithRequiredScope())
+	assert.Nil(t, err)
+	changes := CompareSnapshots(s, strict)
+	assert.NotEmpty(t, changes)
+	for _, c := range changes {
+		assert.True(t, c.Before.Ok, c.Path)
+		assert.False(t, c.After.Ok, c.Path)
+		assert.Equal(t, "scope-required", c.After.Errors[0].Code, c.Path)
+	}
+
+	_, err = NewSnapshot(cctesting.Corpus(), "[", WithTypes(conventionalcommits.TypesConventional))
+	assert.Error(t, err)
+}
+
+func TestCompareSnapshotsFiles(t *testing.T) {
+	previous, _ := NewSnapshot(fstest.MapFS{"a.txt": {Data: []byte("fix: x")}, "b.txt": {Data: []byte("fix: y")}}, "*.txt")
+	current, _ := NewSnapshot(fstest.MapFS{"b.txt": {Data: []byte("fix: y")}, "c.txt": {Data: []byte("fix: z")}}, "*.txt")
+
+	changes := CompareSnapshots(previous, current)
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, "a.txt", changes[0].Path)
+		assert.Nil(t, changes[0].After)
+		assert.Equal(t, "c.txt", changes[1].Path)
+		assert.Nil(t, changes[1].Before)
+		assert.Equal(t, "z", changes[1].After.Description)
+	}
+}
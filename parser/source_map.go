@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// mapType records the position of the type, when the source map is on.
+func (m *machine) mapType() {
+	if m.sourceMap == nil {
+		return
+	}
+	m.sourceMap.Type = conventionalcommits.Span{Start: m.pb, End: m.p}
+}
+
+// mapScope records the position of the scope, when the source map is on.
+func (m *machine) mapScope() {
+	if m.sourceMap == nil {
+		return
+	}
+	m.sourceMap.Scope = conventionalcommits.Span{Start: m.pb, End: m.p}
+}
+
+// mapFooterToken records where the current footer trailer token and separator start.
+func (m *machine) mapFooterToken() {
+	m.footerTokenStart = m.pb
+	m.footerSepStart = m.p
+}
+
+// footerSeparator returns the separator of the current footer trailer, as written.
+func (m *machine) footerSeparator() string {
+	return string(m.data[m.footerSepStart:m.pb])
+}
+
+// mapFooter records the position of the current footer trailer, when the source map is on.
+func (m *machine) mapFooter() {
+	if m.sourceMap == nil {
+		return
+	}
+	m.sourceMap.Footers = append(m.sourceMap.Footers, conventionalcommits.FooterSpan{
+		Token:     m.currentFooterKey,
+		Separator: string(m.data[m.footerSepStart:m.pb]),
+		Span:      conventionalcommits.Span{Start: m.footerTokenStart, End: m.p},
+		TokenSpan: conventionalcommits.Span{Start: m.footerTokenStart, End: m.footerSepStart},
+		ValueSpan: conventionalcommits.Span{Start: m.pb, End: m.p},
+	})
+}
+
+// mappedFooters returns the number of footer trailers in the source map, if any.
+func (m *machine) mappedFooters() int {
+	if m.sourceMap == nil {
+		return 0
+	}
+	return len(m.sourceMap.Footers)
+}
+
+// unmapFooters drops the footer trailers recorded in the source map after the given number of them.
+func (m *machine) unmapFooters(n int) {
+	if m.sourceMap == nil {
+		return
+	}
+	m.sourceMap.Footers = m.sourceMap.Footers[:n]
+}
+
+// unmapFooter drops the i-th footer trailer with the given token from the source map, if any.
+func (m *machine) unmapFooter(token string, i int) {
+	if m.sourceMap == nil {
+		return
+	}
+	for j, f := range m.sourceMap.Footers {
+		if f.Token != token {
+			continue
+		}
+		if i == 0 {
+			m.sourceMap.Footers = append(m.sourceMap.Footers[:j:j], m.sourceMap.Footers[j+1:]...)
+			return
+		}
+		i--
+	}
+}
@@ -0,0 +1,139 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceMapFooters(t *testing.T) {
+	i := []byte("fix: x\n\nsee the issue\n\nReviewed-by: Z\nRefs #133\nBREAKING CHANGE: a b")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+
+	expected := []conventionalcommits.FooterSpan{
+		{
+			Token:     "reviewed-by",
+			Separator: ": ",
+			Span:      conventionalcommits.Span{Start: 23, End: 37},
+			TokenSpan: conventionalcommits.Span{Start: 23, End: 34},
+			ValueSpan: conventionalcommits.Span{Start: 36, End: 37},
+		},
+		{
+			Token:     "refs",
+			Separator: " #",
+			Span:      conventionalcommits.Span{Start: 38, End: 47},
+			TokenSpan: conventionalcommits.Span{Start: 38, End: 42},
+			ValueSpan: conventionalcommits.Span{Start: 44, End: 47},
+		},
+		{
+			Token:     "breaking-change",
+			Separator: ": ",
+			Span:      conventionalcommits.Span{Start: 48, End: 68},
+			TokenSpan: conventionalcommits.Span{Start: 48, End: 63},
+			ValueSpan: conventionalcommits.Span{Start: 65, End: 68},
+		},
+	}
+	assert.Equal(t, expected, res.SourceMap.Footers)
+	assert.Equal(t, "Refs #133", string(res.SourceMap.Footers[1].Span.Text(i)))
+	assert.Equal(t, "BREAKING CHANGE", string(res.SourceMap.Footers[2].TokenSpan.Text(i)))
+	assert.Equal(t, 3, res.SourceMap.Footers[2].ValueSpan.Len())
+}
+
+func TestSourceMapParts(t *testing.T) {
+	i := []byte("feat(API)!: add endpoint\n\n\nfirst paragraph\n\nsecond paragraph\n\nRefs: #1")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, "feat", string(res.SourceMap.Type.Text(i)))
+	assert.Equal(t, "API", string(res.SourceMap.Scope.Text(i)))
+	assert.Equal(t, "add endpoint", string(res.SourceMap.Description.Text(i)))
+	assert.Equal(t, "\nfirst paragraph\n\nsecond paragraph", string(res.SourceMap.Body.Text(i)))
+
+	i = []byte("\xEF\xBB\xBFfix(cli): x\nbody")
+	res = newMachine(WithSkipBOM(), WithLenientBlankLine()).ParseResult(i)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, conventionalcommits.Span{Start: 3, End: 6}, res.SourceMap.Type)
+	assert.Equal(t, "cli", string(res.SourceMap.Scope.Text(i)))
+	assert.Equal(t, "body", string(res.SourceMap.Body.Text(i)))
+
+	res = newMachine().ParseResult([]byte("fix: x"))
+	assert.Equal(t, conventionalcommits.Span{Start: 0, End: 3}, res.SourceMap.Type)
+	assert.Zero(t, res.SourceMap.Scope.Len())
+	assert.Zero(t, res.SourceMap.Body.Len())
+}
+
+func TestSourceMapFootersBestEffort(t *testing.T) {
+	i := []byte("fix: x\n\nAcked-by: Y\nwrong")
+	res := newMachine(WithBestEffort()).ParseResult(i)
+	assert.Error(t, res.Err())
+	assert.Len(t, res.SourceMap.Footers, 1)
+	assert.Equal(t, "Acked-by: Y", string(res.SourceMap.Footers[0].Span.Text(i)))
+}
+
+func TestSourceMapOffByDefault(t *testing.T) {
+	m := NewMachine().(*machine)
+	m.Parse([]byte("fix: x\n\nAcked-by: Y"))
+	assert.Nil(t, m.sourceMap)
+}
+
+func TestOffsetToLineCol(t *testing.T) {
+	i := []byte("fix: x\n\nbody\nwith lines\n\nRefs #1")
+	res := newMachine().ParseResult(i)
+
+	cases := []struct {
+		offset int
+		line   int
+		col    int
+	}{
+		{-1, 1, 0},
+		{0, 1, 0},
+		{5, 1, 5},
+		{6, 1, 6},
+		{7, 2, 0},
+		{8, 3, 0},
+		{13, 4, 0},
+		{17, 4, 4},
+		{25, 6, 0},
+		{len(i), 6, 7},
+	}
+	for _, tc := range cases {
+		line, col := res.SourceMap.OffsetToLineCol(tc.offset)
+		assert.Equal(t, tc.line, line, "line of offset %d", tc.offset)
+		assert.Equal(t, tc.col, col, "column of offset %d", tc.offset)
+
+		line, col = conventionalcommits.OffsetToLineCol(i, tc.offset)
+		assert.Equal(t, tc.line, line, "line of offset %d", tc.offset)
+		assert.Equal(t, tc.col, col, "column of offset %d", tc.offset)
+
+		p := res.SourceMap.Position(tc.offset)
+		assert.Equal(t, tc.line, p.Line, "line of offset %d", tc.offset)
+		assert.Equal(t, tc.col+1, p.Column, "column of offset %d", tc.offset)
+		assert.Equal(t, conventionalcommits.NewPosition(i, tc.offset), p, "position of offset %d", tc.offset)
+	}
+
+	line, col := res.SourceMap.OffsetToLineCol(res.SourceMap.Footers[0].ValueSpan.Start)
+	assert.Equal(t, 6, line)
+	assert.Equal(t, 6, col)
+
+	line, col = conventionalcommits.SourceMap{}.OffsetToLineCol(3)
+	assert.Equal(t, 1, line)
+	assert.Equal(t, 3, col)
+}
+
+func TestSplice(t *testing.T) {
+	i := []byte("fix(api):   typo  \n\nbody  with   odd spacing\n\nRefs #133\nReviewed-by: Z")
+	res := newMachine().ParseResult(i)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, "typo  ", string(res.SourceMap.Description.Text(i)))
+
+	out, err := conventionalcommits.Splice(i, res.SourceMap.EditFooterValue(0, "134"), res.SourceMap.EditDescription("correct the typo"))
+	assert.Nil(t, err)
+	assert.Equal(t, "fix(api):   correct the typo\n\nbody  with   odd spacing\n\nRefs #134\nReviewed-by: Z", string(out))
+	assert.Equal(t, "fix(api):   typo  \n\nbody  with   odd spacing\n\nRefs #133\nReviewed-by: Z", string(i))
+
+	_, err = conventionalcommits.Splice(i, res.SourceMap.EditFooterValue(1, "A"), conventionalcommits.Edit{Span: res.SourceMap.Footers[1].Span, Text: ""})
+	assert.Error(t, err)
+	_, err = conventionalcommits.Splice(i, conventionalcommits.Edit{Span: conventionalcommits.Span{Start: 0, End: 1000}})
+	assert.Error(t, err)
+}
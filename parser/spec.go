@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrSpecDescriptionSpace tells the user that more than one space separates the colon from the description.
+	ErrSpecDescriptionSpace = "expecting the description after a single white-space (' ') character, got '%s' character (specification, item 5)"
+	// ErrSpecBodyBlankLine tells the user that more than one blank line separates the description from the rest.
+	ErrSpecBodyBlankLine = "expecting a single blank line after the description, got another newline (specification, item 6)"
+	// ErrSpecFooterBlankLine tells the user that more than one blank line separates the body from the footers.
+	ErrSpecFooterBlankLine = "expecting a single blank line before the footer trailers, got another newline (specification, item 8)"
+	// ErrSpecBreakingChangeCase tells the user that the breaking change footer token is not uppercase.
+	ErrSpecBreakingChangeCase = "expecting an uppercase breaking change token, got '%s' token (specification, item 15)"
+)
+
+// breakingChangeRegexp matches the lines starting with a breaking change token followed by a separator, in any case.
+var breakingChangeRegexp = regexp.MustCompile(`(?im)^(breaking change)(?::| #)`)
+
+// checkFooterToken checks the token of the current footer trailer against the specification, when strict.
+//
+// It remembers the first violation, so that checkSpec can report it after the machine ran.
+func (m *machine) checkFooterToken() {
+	if !m.strictSpec {
+		return
+	}
+	if m.firstFooterStart < 0 {
+		m.firstFooterStart = m.footerTokenStart
+	}
+	token := m.data[m.footerTokenStart:m.footerSepStart]
+	if m.footerViolation == nil && m.currentFooterKey == "breaking-change" && !bytes.Equal(token, bytes.ToUpper(token)) {
+		span := conventionalcommits.Span{Start: m.footerTokenStart, End: m.footerSepStart}
+		m.footerViolation = m.emitErrorAt(span, ErrSpecBreakingChangeCase, string(token), m.footerTokenStart)
+	}
+}
+
+// checkSpec checks the MUSTs of the specification the machine is permissive about.
+//
+// It does not check that types are nouns.
+func (m *machine) checkSpec(output *conventionalCommit) error {
+	headerEnd := bytes.IndexByte(m.data, '\n')
+	if headerEnd < 0 {
+		headerEnd = m.pe
+	}
+
+	// The description ends the header
+	descrStart := headerEnd - len(output.descr)
+	if descrStart >= 2 && m.data[descrStart-2] == ' ' {
+		m.p = descrStart - 1
+		for m.data[m.p-1] == ' ' {
+			m.p--
+		}
+		m.p++
+		return m.emitErrorOnCurrentCharacter(ErrSpecDescriptionSpace)
+	}
+
+	if n := countNewlines(m.data[headerEnd:]); n > 2 && headerEnd+n < m.pe {
+		m.p = headerEnd + 2
+		return m.emitErrorWithoutCharacter(ErrSpecBodyBlankLine)
+	}
+
+	if m.firstFooterStart > headerEnd {
+		n := 0
+		for m.data[m.firstFooterStart-n-1] == '\n' {
+			n++
+		}
+		if n > 2 && m.firstFooterStart-n > headerEnd {
+			m.p = m.firstFooterStart - n + 2
+			return m.emitErrorWithoutCharacter(ErrSpecFooterBlankLine)
+		}
+	}
+
+	if m.footerViolation != nil {
+		return m.footerViolation
+	}
+
+	// The grammar only recognizes the uppercase "BREAKING CHANGE" token, reading the other ones as text
+	for _, loc := range breakingChangeRegexp.FindAllSubmatchIndex(m.data[headerEnd:m.pe], -1) {
+		span := conventionalcommits.Span{Start: headerEnd + loc[2], End: headerEnd + loc[3]}
+		if token := span.Text(m.data); !bytes.Equal(token, bytes.ToUpper(token)) {
+			return m.emitErrorAt(span, ErrSpecBreakingChangeCase, string(token), span.Start)
+		}
+	}
+
+	return nil
+}
+
+// countNewlines returns the number of consecutive newlines at the beginning of the given data.
+func countNewlines(data []byte) int {
+	n := 0
+	for n < len(data) && data[n] == '\n' {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// Squash synthesizes the squash commit of the given commit messages, like the ones of a pull request,
+// so that merge automation writes a clean conventional commit.
+//
+// A machine configured with the given options parses the commit messages, which must all be valid.
+// The leading commit message is the first feature, else the first fix, else the first commit message:
+// the squash commit has its type and its description.
+// It keeps the scope only when all the commit messages share it, and it is breaking when any of them is.
+// Its body lists the headers of the other commit messages, and its trailers are the ones of all the commit messages.
+//
+// Use Build to render and validate the resulting draft.
+func Squash(messages [][]byte, options ...conventionalcommits.MachineOption) (Draft, error) {
+	if len(messages) == 0 {
+		return Draft{}, fmt.Errorf("no commit messages to squash")
+	}
+
+	m := NewMachine(options...)
+	commits := make([]*conventionalcommits.ConventionalCommit, len(messages))
+	for i, input := range messages {
+		msg, err := m.Parse(input)
+		if err != nil {
+			return Draft{}, fmt.Errorf("commit message %d: %w", i, err)
+		}
+		commits[i] = msg.(*conventionalcommits.ConventionalCommit)
+	}
+
+	lead := leadingCommit(commits)
+	d := Draft{Type: commits[lead].Type, Description: commits[lead].Description}
+	if s := commits[lead].Scope; s != nil {
+		d.Scope = *s
+	}
+	headers := []string{}
+	for i, c := range commits {
+		d.Breaking = d.Breaking || c.IsBreakingChange()
+		if c.Scope == nil || *c.Scope != d.Scope {
+			d.Scope = ""
+		}
+		if i != lead {
+			headers = append(headers, "- "+Draft{Type: c.Type, Scope: scopeOf(c), Breaking: c.Exclamation, Description: c.Description}.String())
+		}
+	}
+	d.Body = strings.Join(headers, "\n")
+	d.Trailers = squashTrailers(commits)
+
+	return d, nil
+}
+
+// leadingCommit returns the index of the first feature, else of the first fix, else 0.
+func leadingCommit(commits []*conventionalcommits.ConventionalCommit) int {
+	for _, match := range []conventionalcommits.Predicate{
+		(*conventionalcommits.ConventionalCommit).IsFeature,
+		(*conventionalcommits.ConventionalCommit).IsFix,
+	} {
+		for i, c := range commits {
+			if match(c) {
+				return i
+			}
+		}
+	}
+
+	return 0
+}
+
+// scopeOf returns the scope of the given commit message, if any.
+func scopeOf(c *conventionalcommits.ConventionalCommit) string {
+	if c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// squashTrailers returns the trailers of all the given commit messages, without duplicates, sorted by token.
+func squashTrailers(commits []*conventionalcommits.ConventionalCommit) []Trailer {
+	seen := map[Trailer]bool{}
+	trailers := []Trailer{}
+	for _, c := range commits {
+		tokens := make([]string, 0, len(c.Footers))
+		for token := range c.Footers {
+			tokens = append(tokens, token)
+		}
+		sort.Strings(tokens)
+		for _, token := range tokens {
+			for _, value := range c.Footers[token] {
+				t := Trailer{Token: trailerToken(token), Value: value}
+				if !seen[t] {
+					seen[t] = true
+					trailers = append(trailers, t)
+				}
+			}
+		}
+	}
+	sort.SliceStable(trailers, func(a, b int) bool {
+		return trailers[a].Token < trailers[b].Token
+	})
+
+	return trailers
+}
+
+// trailerToken renders the given token of the footers, which the parser lowercases (eg., "reviewed-by" becomes "Reviewed-by").
+func trailerToken(token string) string {
+	if token == "breaking-change" {
+		return "BREAKING CHANGE"
+	}
+	return strings.ToUpper(token[:1]) + token[1:]
+}
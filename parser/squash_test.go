@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSquash(t *testing.T) {
+	opts := []conventionalcommits.MachineOption{WithTypes(conventionalcommits.TypesConventional)}
+	d, err := Squash([][]byte{
+		[]byte("test(api): cover empty payloads"),
+		[]byte("feat(api): accept empty payloads\n\nRefs: #12"),
+		[]byte("fix(api)!: reject nil payloads\n\nRefs: #12\nReviewed-by: Leo"),
+	}, opts...)
+	assert.Nil(t, err)
+	out, err := d.Build(opts...)
+	assert.Nil(t, err)
+	assert.Equal(t, "feat(api)!: accept empty payloads\n\n- test(api): cover empty payloads\n- fix(api)!: reject nil payloads\n\nRefs: #12\nReviewed-by: Leo", string(out))
+
+	// The scope is kept only when shared, and fixes lead over the other types
+	d, err = Squash([][]byte{
+		[]byte("docs: update readme"),
+		[]byte("fix(api): typo\n\nBREAKING CHANGE: v1 is gone"),
+	}, opts...)
+	assert.Nil(t, err)
+	assert.Equal(t, "fix!: typo\n\n- docs: update readme\n\nBREAKING CHANGE: v1 is gone", d.String())
+
+	d, err = Squash([][]byte{[]byte("chore: bump deps")}, opts...)
+	assert.Nil(t, err)
+	assert.Equal(t, "chore: bump deps", d.String())
+
+	_, err = Squash([][]byte{[]byte("fix: typo"), []byte("oops")}, opts...)
+	assert.EqualError(t, err, "commit message 1: "+fmt.Sprintf(ErrType+ColumnPositionTemplate, "o", 0))
+
+	_, err = Squash(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// suggest returns how to fix the error with the given template and arguments occurring in the input, if known.
+func (m *machine) suggest(input []byte, template string, args []interface{}) string {
+	col := args[len(args)-1].(int)
+	switch template {
+	case ErrType, ErrTypeIncomplete:
+		if t, ok := m.closestType(input); ok {
+			return fmt.Sprintf("did you mean '%s'?", t)
+		}
+		return ""
+	case ErrColon:
+		switch {
+		case col < len(input) && input[col] == ' ':
+			return fmt.Sprintf("insert ':' after '%s'", input[:col])
+		case col > 0 && (input[col-1] == ')' || input[col-1] == '!'):
+			return fmt.Sprintf("insert ': ' after '%s'", input[:col])
+		}
+		// Within a word, the type is likely wrong rather than the colon missing
+		return ""
+	case ErrDescriptionInit, ErrMissingColonSpace:
+		return "insert ' ' after the colon"
+	case ErrDescription:
+		return "add a description after the colon"
+	case ErrScopeIncomplete:
+		return "insert ')' to close the scope"
+	case ErrScopeRequired:
+		return fmt.Sprintf("add a scope between parentheses after '%s'", strings.TrimSuffix(string(input[:col]), "("))
+	case ErrMissingBlankLineAtBeginning:
+		return "add a blank line before the body"
+	case ErrSpecBodyBlankLine:
+		return "remove the extra blank line after the description"
+	case ErrSpecFooterBlankLine:
+		return "remove the extra blank line before the footer trailers"
+	case ErrSpecDescriptionSpace:
+		return "remove the extra white-space characters after the colon"
+	case ErrTabSeparator:
+		return "replace the tab with a space"
+	case ErrSpecBreakingChangeCase:
+		return fmt.Sprintf("write '%s' instead", strings.ToUpper(args[0].(string)))
+	case ErrTypeCase:
+		return fmt.Sprintf("write '%s' instead", strings.ToLower(args[0].(string)))
+	case ErrTrailingPeriod:
+		return "remove the trailing period"
+	case ErrDuplicateFooter:
+		return fmt.Sprintf("remove the repeated '%s' trailer", args[0])
+	case ErrFooterNotAllowed:
+		tokens := make([]string, len(m.allowedFooters))
+		for i, t := range m.allowedFooters {
+			tokens[i] = "'" + t + "'"
+		}
+		return fmt.Sprintf("use one of %s instead", strings.Join(tokens, ", "))
+	case ErrChangeID, ErrChangeIDRequired:
+		return "add the Change-Id the commit-msg hook of Gerrit generates"
+	case ErrDescriptionCase:
+		r, _ := utf8.DecodeRuneInString(args[1].(string))
+		if unicode.IsUpper(r) {
+			r = unicode.ToLower(r)
+		} else {
+			r = unicode.ToUpper(r)
+		}
+		return fmt.Sprintf("write '%c' instead", r)
+	case ErrHeaderLength:
+		return fmt.Sprintf("shorten the header to %d characters, moving the details into the body", args[0])
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestions(t *testing.T) {
+	cases := []struct {
+		input      string
+		options    []conventionalcommits.MachineOption
+		suggestion string
+	}{
+		{"fix x", nil, "insert ':' after 'fix'"},
+		{"fix(api)x", nil, "insert ': ' after 'fix(api)'"},
+		{"fixed: x", nil, ""},
+		{"fix:x", nil, "insert ' ' after the colon"},
+		{"fix(api: x", nil, "insert ')' to close the scope"},
+		{"fix: x\nbody", nil, "add a blank line before the body"},
+		{"feat!: x", []conventionalcommits.MachineOption{WithRequiredScope()}, "add a scope between parentheses after 'feat'"},
+		{"fix: x\n\n\nbody", []conventionalcommits.MachineOption{WithStrictSpec()}, "remove the extra blank line after the description"},
+		{"fix: x\n\nBreaking-Change: y", []conventionalcommits.MachineOption{WithStrictSpec()}, "write 'BREAKING-CHANGE' instead"},
+		{"fix: Correct typo", []conventionalcommits.MachineOption{WithDescriptionCase(conventionalcommits.CaseLowerFirst)}, "write 'c' instead"},
+		{"fix: correct typo.", []conventionalcommits.MachineOption{WithNoTrailingPeriod()}, "remove the trailing period"},
+		{"fiz: x", nil, "did you mean 'fix'?"},
+	}
+	for _, tc := range cases {
+		_, err := NewMachine(tc.options...).Parse([]byte(tc.input))
+		if assert.Error(t, err, tc.input) {
+			assert.Equal(t, tc.suggestion, err.(interface{ Suggestion() string }).Suggestion(), tc.input)
+			assert.Equal(t, tc.suggestion, conventionalcommits.NewDiagnostic(err, conventionalcommits.SeverityError).Suggestion, tc.input)
+		}
+	}
+
+	// Warnings have suggestions too, about the original input
+	res := newMachine(WithLenientColonSpace()).ParseResult([]byte("fix:x"))
+	assert.Equal(t, "insert ' ' after the colon", res.Diagnostics()[0].Suggestion)
+}
+
+func TestClosestTypeSuggestions(t *testing.T) {
+	conventional := WithTypes(conventionalcommits.TypesConventional)
+	cases := []struct {
+		input      string
+		options    []conventionalcommits.MachineOption
+		suggestion string
+	}{
+		{"faet: x", nil, "did you mean 'feat'?"},
+		{"fiz(api): x", nil, "did you mean 'fix'?"},
+		{"FAET: x", nil, "did you mean 'feat'?"},
+		{"fe", nil, "did you mean 'feat'?"},
+		{"dcos: x", []conventionalcommits.MachineOption{conventional}, "did you mean 'docs'?"},
+		{"refactr: x", []conventionalcommits.MachineOption{conventional}, "did you mean 'refactor'?"},
+		{"release: x", []conventionalcommits.MachineOption{conventional}, ""},
+		{"x: y", nil, ""},
+		{"ftr: x", []conventionalcommits.MachineOption{WithTypeRegistry(conventionalcommits.NewTypeRegistryOf("feature"))}, ""},
+		{"featur: x", []conventionalcommits.MachineOption{WithTypeRegistry(conventionalcommits.NewTypeRegistryOf("feature"))}, "did you mean 'feature'?"},
+	}
+	for _, tc := range cases {
+		_, err := NewMachine(tc.options...).Parse([]byte(tc.input))
+		var typeErr *TypeError
+		if assert.ErrorAs(t, err, &typeErr, tc.input) {
+			assert.Equal(t, tc.suggestion, typeErr.Suggestion(), tc.input)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CodeCount tells how many diagnostics have a given code.
+type CodeCount struct {
+	Code  string `json:"code"`
+	Count int    `json:"count"`
+}
+
+// Summary aggregates the records of a stream of commit messages, so that CI logs are scannable at a glance.
+type Summary struct {
+	// Total is the number of commit messages.
+	Total int `json:"total"`
+	// Passed is the number of valid commit messages.
+	Passed int `json:"passed"`
+	// Errors is the number of errors.
+	Errors int `json:"errors"`
+	// Warnings is the number of warnings.
+	Warnings int `json:"warnings"`
+	// Codes counts the errors and the warnings by code, from the most common.
+	Codes []CodeCount `json:"codes"`
+}
+
+// Add accounts for the given record.
+func (s *Summary) Add(r Record) {
+	s.Total++
+	if r.Ok {
+		s.Passed++
+	}
+	s.Errors += len(r.Errors)
+	s.Warnings += len(r.Warnings)
+	for _, d := range append(append([]RecordDiagnostic{}, r.Errors...), r.Warnings...) {
+		s.count(d.Code)
+	}
+}
+
+// count increments the count of the given code, keeping the most common codes first.
+func (s *Summary) count(code string) {
+	i := 0
+	for i < len(s.Codes) && s.Codes[i].Code != code {
+		i++
+	}
+	if i == len(s.Codes) {
+		s.Codes = append(s.Codes, CodeCount{Code: code})
+	}
+	s.Codes[i].Count++
+	sort.SliceStable(s.Codes, func(a, b int) bool {
+		if s.Codes[a].Count != s.Codes[b].Count {
+			return s.Codes[a].Count > s.Codes[b].Count
+		}
+		return s.Codes[a].Code < s.Codes[b].Code
+	})
+}
+
+// String renders the summary for CI logs.
+func (s Summary) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d commit messages: %d passed, %d failed, %d errors, %d warnings", s.Total, s.Passed, s.Total-s.Passed, s.Errors, s.Warnings)
+	if len(s.Codes) > 0 {
+		counts := make([]string, len(s.Codes))
+		for i, c := range s.Codes {
+			counts[i] = fmt.Sprintf("%s (%d)", c.Code, c.Count)
+		}
+		fmt.Fprintf(&b, "\nmost common: %s", strings.Join(counts, ", "))
+	}
+
+	return b.String()
+}
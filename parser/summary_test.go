@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummary(t *testing.T) {
+	s := Summary{}
+	s.Add(Record{Ok: true})
+	s.Add(Record{Errors: []RecordDiagnostic{{Code: "colon"}}})
+	s.Add(Record{Ok: true, Warnings: []RecordDiagnostic{{Code: "trivial-description"}}})
+	s.Add(Record{Errors: []RecordDiagnostic{{Code: "trivial-description"}}})
+
+	assert.Equal(t, Summary{
+		Total:    4,
+		Passed:   2,
+		Errors:   2,
+		Warnings: 1,
+		Codes:    []CodeCount{{Code: "trivial-description", Count: 2}, {Code: "colon", Count: 1}},
+	}, s)
+	assert.Equal(t, "4 commit messages: 2 passed, 2 failed, 2 errors, 1 warnings\nmost common: trivial-description (2), colon (1)", s.String())
+
+	out, err := json.Marshal(s)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"total":4,"passed":2,"errors":2,"warnings":1,"codes":[{"code":"trivial-description","count":2},{"code":"colon","count":1}]}`, string(out))
+
+	assert.Equal(t, "0 commit messages: 0 passed, 0 failed, 0 errors, 0 warnings", Summary{}.String())
+}
@@ -631,6 +631,10 @@ Signed-off-by: Leo`),
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -639,6 +643,10 @@ Signed-off-by: Leo`),
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		"",
 	},
@@ -660,6 +668,10 @@ Signed-off-by: Leo`),
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -668,6 +680,10 @@ Signed-off-by: Leo`),
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		"",
 	},
@@ -689,6 +705,10 @@ Signed-off-by: Leo
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -697,6 +717,10 @@ Signed-off-by: Leo
 				"fixes":         {"3"},
 				"signed-off-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		"",
 	},
@@ -715,6 +739,11 @@ Fixes #5`),
 			Footers: map[string][]string{
 				"fixes": {"3", "4", "5"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "fixes", Separator: " #", Value: "4"},
+				{Token: "fixes", Separator: " #", Value: "5"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -722,6 +751,11 @@ Fixes #5`),
 			Footers: map[string][]string{
 				"fixes": {"3", "4", "5"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "3"},
+				{Token: "fixes", Separator: " #", Value: "4"},
+				{Token: "fixes", Separator: " #", Value: "5"},
+			},
 		},
 		"",
 	},
@@ -752,6 +786,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -762,6 +801,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		"",
 	},
@@ -791,6 +835,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -801,6 +850,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		"",
 	},
@@ -833,6 +887,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -844,6 +903,11 @@ Signed-off-by: Leonardo Di Donato <some@email.com>`),
 				"co-authored-by": {"My other personality <persona@email.com>"},
 				"signed-off-by":  {"Leonardo Di Donato <some@email.com>"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: " #", Value: "22"},
+				{Token: "co-authored-by", Separator: ": ", Value: "My other personality <persona@email.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Leonardo Di Donato <some@email.com>"},
+			},
 		},
 		"",
 	},
@@ -1561,6 +1625,10 @@ similar to menuconfig and nconfig.`),
 					"Masahiro Yamada <masahiroy@kernel.org>",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "signed-off-by", Separator: ": ", Value: "Randy Dunlap <rdunlap@infradead.org>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Masahiro Yamada <masahiroy@kernel.org>"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "kconfig",
@@ -1580,6 +1648,10 @@ similar to menuconfig and nconfig.`),
 					"Masahiro Yamada <masahiroy@kernel.org>",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "signed-off-by", Separator: ": ", Value: "Randy Dunlap <rdunlap@infradead.org>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Masahiro Yamada <masahiroy@kernel.org>"},
+			},
 		},
 		"",
 	},
@@ -1697,6 +1769,19 @@ with this approach.
 					"Leonardo Di Donato <leodidonato@gmail.com>",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: ": ", Value: "849fa50662fb (\"bpf/verifier: refine retval R0 state for bpf_get_stack helper\")"},
+				{Token: "reported-by", Separator: ": ", Value: "Lorenzo Fontana <fontanalorenz@gmail.com>"},
+				{Token: "reported-by", Separator: ": ", Value: "Leonardo Di Donato <leodidonato@gmail.com>"},
+				{Token: "reported-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Daniel Borkmann <daniel@iogearbox.net>"},
+				{Token: "acked-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "acked-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "Lorenzo Fontana <fontanalorenz@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "Leonardo Di Donato <leodidonato@gmail.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Greg Kroah-Hartman <gregkh@linuxfoundation.org>"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "bpf",
@@ -1759,6 +1844,19 @@ with this approach.
 					"Leonardo Di Donato <leodidonato@gmail.com>",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: ": ", Value: "849fa50662fb (\"bpf/verifier: refine retval R0 state for bpf_get_stack helper\")"},
+				{Token: "reported-by", Separator: ": ", Value: "Lorenzo Fontana <fontanalorenz@gmail.com>"},
+				{Token: "reported-by", Separator: ": ", Value: "Leonardo Di Donato <leodidonato@gmail.com>"},
+				{Token: "reported-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Daniel Borkmann <daniel@iogearbox.net>"},
+				{Token: "acked-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "acked-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "John Fastabend <john.fastabend@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "Lorenzo Fontana <fontanalorenz@gmail.com>"},
+				{Token: "tested-by", Separator: ": ", Value: "Leonardo Di Donato <leodidonato@gmail.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Greg Kroah-Hartman <gregkh@linuxfoundation.org>"},
+			},
 		},
 		"",
 	},
@@ -1804,6 +1902,14 @@ failure mode. Also fix mislabeled probed vs direct bitfield test cases.`),
 					"https://lore.kernel.org/bpf/20210426192949.416837-6-andrii@kernel.org",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: ": ", Value: "124a892d1c41 (\"selftests/bpf: Test TYPE_EXISTS and TYPE_SIZE CO-RE relocations\")"},
+				{Token: "reported-by", Separator: ": ", Value: "Lorenz Bauer <lmb@cloudflare.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Andrii Nakryiko <andrii@kernel.org>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "acked-by", Separator: ": ", Value: "Lorenz Bauer <lmb@cloudflare.com>"},
+				{Token: "link", Separator: ": ", Value: "https://lore.kernel.org/bpf/20210426192949.416837-6-andrii@kernel.org"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "selftests/bpf",
@@ -1830,6 +1936,14 @@ failure mode. Also fix mislabeled probed vs direct bitfield test cases.`),
 					"https://lore.kernel.org/bpf/20210426192949.416837-6-andrii@kernel.org",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "fixes", Separator: ": ", Value: "124a892d1c41 (\"selftests/bpf: Test TYPE_EXISTS and TYPE_SIZE CO-RE relocations\")"},
+				{Token: "reported-by", Separator: ": ", Value: "Lorenz Bauer <lmb@cloudflare.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Andrii Nakryiko <andrii@kernel.org>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "acked-by", Separator: ": ", Value: "Lorenz Bauer <lmb@cloudflare.com>"},
+				{Token: "link", Separator: ": ", Value: "https://lore.kernel.org/bpf/20210426192949.416837-6-andrii@kernel.org"},
+			},
 		},
 		"",
 	},
@@ -1854,6 +1968,11 @@ Link: https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com`),
 					"https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "signed-off-by", Separator: ": ", Value: "Martin KaFai Lau <kafai@fb.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "link", Separator: ": ", Value: "https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "bpf",
@@ -1867,6 +1986,11 @@ Link: https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com`),
 					"https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "signed-off-by", Separator: ": ", Value: "Martin KaFai Lau <kafai@fb.com>"},
+				{Token: "signed-off-by", Separator: ": ", Value: "Alexei Starovoitov <ast@kernel.org>"},
+				{Token: "link", Separator: ": ", Value: "https://lore.kernel.org/bpf/20210325015252.1551395-1-kafai@fb.com"},
+			},
 		},
 		"",
 	},
@@ -1996,6 +2120,9 @@ BREAKING CHANGE: APIs`),
 					"APIs",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -2005,6 +2132,9 @@ BREAKING CHANGE: APIs`),
 					"APIs",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		"",
 	},
@@ -2023,6 +2153,9 @@ BREAKING-CHANGE: APIs`),
 					"APIs",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -2032,6 +2165,9 @@ BREAKING-CHANGE: APIs`),
 					"APIs",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		"",
 	},
@@ -2054,6 +2190,10 @@ Acked-by: Leo Di Donato`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -2066,6 +2206,10 @@ Acked-by: Leo Di Donato`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+			},
 		},
 		"",
 	},
@@ -2089,6 +2233,10 @@ BREAKING CHANGE: APIs`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -2101,6 +2249,10 @@ BREAKING CHANGE: APIs`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		"",
 	},
@@ -2126,6 +2278,10 @@ BREAKING CHANGE: APIs`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		&conventionalcommits.ConventionalCommit{
 			Type:        "fix",
@@ -2138,6 +2294,10 @@ BREAKING CHANGE: APIs`),
 					"Leo Di Donato",
 				},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "acked-by", Separator: ": ", Value: "Leo Di Donato"},
+				{Token: "breaking-change", Separator: ": ", Value: "APIs"},
+			},
 		},
 		"",
 	},
@@ -2179,6 +2339,9 @@ BREAKING CHANGE #5`),
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, " ", 48),
 	},
@@ -2198,6 +2361,9 @@ BREAKING CHANG: XYZ`),
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, ":", 47),
 	},
@@ -2217,6 +2383,9 @@ breaking change: xyz`),
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "c", 42),
 	},
@@ -2236,6 +2405,9 @@ Tested-by: Leo
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "!", 33),
 	},
@@ -2256,6 +2428,9 @@ a
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\n", 34),
 	},
@@ -2275,6 +2450,9 @@ a`),
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailerIncomplete+ColumnPositionTemplate, "a", 34),
 	},
@@ -2295,6 +2473,9 @@ Another-trailer: x`),
 			Footers: map[string][]string{
 				"tested-by": {"Leo"},
 			},
+			OrderedFooters: []conventionalcommits.Footer{
+				{Token: "tested-by", Separator: ": ", Value: "Leo"},
+			},
 		},
 		fmt.Sprintf(ErrTrailer+ColumnPositionTemplate, "\n", 35),
 	},
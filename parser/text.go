@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrNotText tells the user that the input looks like binary data rather than text.
+	ErrNotText = "expecting text, got binary data"
+)
+
+const (
+	// textSampleSize is the number of bytes at the beginning of the input where to look for invalid UTF-8 sequences.
+	textSampleSize = 1024
+	// maxInvalidUTF8Ratio is the fraction of invalid UTF-8 bytes in the sample above which the input is not text.
+	maxInvalidUTF8Ratio = 0.3
+)
+
+// NotTextError is the error returned when the input contains NUL bytes or too many invalid UTF-8 sequences.
+type NotTextError struct {
+	Column    int
+	position  conventionalcommits.Position
+	formatter conventionalcommits.ErrorFormatter
+}
+
+// Error returns the message of the error, followed by the column of the first byte which is not text,
+// unless a custom formatter renders it.
+func (e *NotTextError) Error() string {
+	if e.formatter != nil {
+		return format(e.formatter, e.Diagnostic(), e.position, ErrNotText)
+	}
+	return fmt.Sprintf(ErrNotText+ColumnPositionTemplate, e.Column)
+}
+
+// Diagnostic converts the error into a diagnostic.
+func (e *NotTextError) Diagnostic() conventionalcommits.Diagnostic {
+	return conventionalcommits.Diagnostic{
+		Code:     codes[ErrNotText],
+		Severity: conventionalcommits.SeverityError,
+		Range:    conventionalcommits.Span{Start: e.Column, End: e.Column + 1},
+		Message:  ErrNotText,
+	}
+}
+
+// Position returns the line and the column where the error occurs.
+func (e *NotTextError) Position() conventionalcommits.Position {
+	return e.position
+}
+
+// checkText returns an error when the input is obviously not text, so that the machine does not run on it.
+//
+// Any NUL byte makes the input binary, while invalid UTF-8 sequences do only when they are dense
+// at the beginning of the input, since messages in legacy encodings are not valid UTF-8 either.
+func (m *machine) checkText() error {
+	col := bytes.IndexByte(m.data, 0)
+	if col < 0 {
+		col = firstInvalidUTF8InDenseSample(m.data)
+	}
+	if col < 0 {
+		return nil
+	}
+
+	e := &NotTextError{Column: col, position: m.position(m.data, col), formatter: m.errorFormatter}
+	if m.logger != nil {
+		m.logger.Errorln(e)
+	}
+	return e
+}
+
+// firstInvalidUTF8InDenseSample returns the position of the first invalid UTF-8 byte of the sample at the beginning
+// of the input, when such bytes are too many, or -1.
+func firstInvalidUTF8InDenseSample(data []byte) int {
+	sample := data
+	if len(sample) > textSampleSize {
+		sample = sample[:textSampleSize]
+	}
+	first, invalid := -1, 0
+	for i := 0; i < len(sample); {
+		r, size := utf8.DecodeRune(sample[i:])
+		// A rune cut by the end of the sample is not invalid
+		if r == utf8.RuneError && size == 1 && (len(sample) == len(data) || utf8.FullRune(sample[i:])) {
+			if first < 0 {
+				first = i
+			}
+			invalid++
+		}
+		i += size
+	}
+	if float64(invalid) > maxInvalidUTF8Ratio*float64(len(sample)) {
+		return first
+	}
+	return -1
+}
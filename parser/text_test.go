@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotText(t *testing.T) {
+	p := newMachine(WithBestEffort())
+
+	res, err := p.Parse([]byte("fix: x\n\nbody\x00"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrNotText+ColumnPositionTemplate, 12))
+	var notText *NotTextError
+	assert.True(t, errors.As(err, &notText))
+	assert.Equal(t, 12, notText.Column)
+
+	res, err = p.Parse([]byte("\x89PNG\r\n\x1a\n" + strings.Repeat("\xff\xfe", 100)))
+	assert.Nil(t, res)
+	assert.True(t, errors.As(err, &notText))
+	assert.Equal(t, 0, notText.Column)
+
+	// A few bytes in legacy encodings are not enough to reject the input
+	res, err = p.Parse([]byte("fix: caf\xe9 menu\n\nwith cr\xe8me br\xfbl\xe9e"))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	// Nor is a rune cut by the end of the sample
+	res, err = p.Parse([]byte("fix: " + strings.Repeat("é", 2000)))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+
+	d := p.ParseResult([]byte("fix\x00")).Diagnostics()
+	assert.Equal(t, "not-text", d[0].Code)
+}
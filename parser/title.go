@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// verbTypes maps the verbs commonly starting non-conventional titles to the type they imply.
+var verbTypes = map[string]string{
+	"add":       "feat",
+	"implement": "feat",
+	"introduce": "feat",
+	"support":   "feat",
+	"fix":       "fix",
+	"correct":   "fix",
+	"repair":    "fix",
+	"resolve":   "fix",
+	"document":  "docs",
+	"refactor":  "refactor",
+	"rename":    "refactor",
+	"simplify":  "refactor",
+	"extract":   "refactor",
+	"optimize":  "perf",
+	"speed":     "perf",
+	"test":      "test",
+	"bump":      "chore",
+	"upgrade":   "chore",
+	"revert":    "revert",
+}
+
+// SuggestTitle suggests a compliant version of the given title, like the one of a pull request.
+//
+// It first fixes the title like Fix does.
+// When the title has no type, it infers one from its first verb (eg., "Add endpoint" becomes "feat: Add endpoint"),
+// then fixes the result again, as long as the machine configured with the given options accepts the inferred type.
+// It returns the error of the title when it has no suggestion.
+func SuggestTitle(title string, options ...conventionalcommits.MachineOption) (string, error) {
+	title = strings.TrimSpace(title)
+	if i := strings.IndexByte(title, '\n'); i >= 0 {
+		title = strings.TrimSpace(title[:i])
+	}
+
+	out, err := Fix([]byte(title), options...)
+	if err == nil {
+		return string(out), nil
+	}
+
+	t, ok := inferType(title)
+	if !ok {
+		return "", err
+	}
+	if out, e := Fix([]byte(t+": "+title), options...); e == nil {
+		return string(out), nil
+	}
+
+	return "", err
+}
+
+// verbSuffixes are the suffixes of the inflected forms of the verbs (eg., "fixes", "renamed", or "simplifies"),
+// and their replacements, in the order inferType tries them.
+var verbSuffixes = [][2]string{
+	{"ies", "y"},
+	{"ied", "y"},
+	{"es", ""},
+	{"s", ""},
+	{"ed", ""},
+	{"d", ""},
+}
+
+// inferType infers the type of a commit message from the first verb of its description, be it inflected or not.
+func inferType(description string) (string, bool) {
+	verb := strings.ToLower(strings.SplitN(description, " ", 2)[0])
+	if t, ok := verbTypes[verb]; ok {
+		return t, true
+	}
+	for _, s := range verbSuffixes {
+		if !strings.HasSuffix(verb, s[0]) {
+			continue
+		}
+		if t, ok := verbTypes[strings.TrimSuffix(verb, s[0])+s[1]]; ok {
+			return t, true
+		}
+	}
+	return "", false
+}
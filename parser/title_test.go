@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestTitle(t *testing.T) {
+	conventional := WithTypes(conventionalcommits.TypesConventional)
+	lower := WithDescriptionCase(conventionalcommits.CaseLowerFirst)
+	cases := []struct {
+		title   string
+		options []conventionalcommits.MachineOption
+		output  string
+	}{
+		{"feat: add endpoint", nil, "feat: add endpoint"},
+		{"  feat:add endpoint  ", nil, "feat: add endpoint"},
+		{"faet(api) add endpoint", nil, "feat(api): add endpoint"},
+		{"Add endpoint", nil, "feat: Add endpoint"},
+		{"Adds endpoint", []conventionalcommits.MachineOption{lower}, "feat: adds endpoint"},
+		{"Fixed crash on empty payloads", nil, "fix: Fixed crash on empty payloads"},
+		{"Fixes crash on empty payloads", nil, "fix: Fixes crash on empty payloads"},
+		{"Renamed the option", []conventionalcommits.MachineOption{conventional}, "refactor: Renamed the option"},
+		{"Simplifies the parser", []conventionalcommits.MachineOption{conventional}, "refactor: Simplifies the parser"},
+		{"Resolves the conflict", nil, "fix: Resolves the conflict"},
+		{"Document the options", []conventionalcommits.MachineOption{conventional, lower, WithNoTrailingPeriod()}, "docs: document the options"},
+	}
+	for _, tc := range cases {
+		out, err := SuggestTitle(tc.title, tc.options...)
+		assert.Nil(t, err, tc.title)
+		assert.Equal(t, tc.output, out, tc.title)
+	}
+
+	// The minimal types do not contain docs
+	_, err := SuggestTitle("Document the options")
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "D", 0))
+
+	_, err = SuggestTitle("Release v2")
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "R", 0))
+}
@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+)
+
+const (
+	// trailingWhitespace contains the characters trimmed from the end of the input.
+	trailingWhitespace = " \t\r\n"
+	// lineTrailingWhitespace contains the characters trimmed from the end of the description and the footer trailer values.
+	lineTrailingWhitespace = " \t"
+)
+
+// trimInput makes the machine ignore the trailing whitespace and newlines of the input.
+func (m *machine) trimInput() {
+	m.data = bytes.TrimRight(m.data, trailingWhitespace)
+	m.pe = len(m.data)
+	m.eof = len(m.data)
+}
+
+// trimOutput removes the trailing whitespace from the description, the body, and the footer trailer values,
+// and from the spans of the footer trailers in the source map.
+func (m *machine) trimOutput(output *conventionalCommit) {
+	output.descr = strings.TrimRight(output.descr, lineTrailingWhitespace)
+	output.body = bytes.TrimRight(output.body, trailingWhitespace)
+	for key, values := range output.footers {
+		for i, v := range values {
+			values[i] = strings.TrimRight(v, lineTrailingWhitespace)
+		}
+		output.footers[key] = values
+	}
+	if m.sourceMap == nil {
+		return
+	}
+	for i := range m.sourceMap.Footers {
+		f := &m.sourceMap.Footers[i]
+		for f.ValueSpan.End > f.ValueSpan.Start && strings.IndexByte(lineTrailingWhitespace, m.data[f.ValueSpan.End-1]) >= 0 {
+			f.ValueSpan.End--
+		}
+		f.Span.End = f.ValueSpan.End
+	}
+}
@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+const (
+	// ErrTypePattern tells the user that the type does not match the type pattern.
+	ErrTypePattern = "type '%s' not matching the '%s' pattern"
+)
+
+// checkType checks the type at the beginning of the input against the type registry.
+//
+// It emits the same errors the machines for the built-in type sets emit for the type part.
+// It does not emit any error when the input is empty or when it ends just after a valid type,
+// leaving these cases to the machine.
+func (m *machine) checkType(registry *conventionalcommits.TypeRegistry) error {
+	defer func() {
+		m.p = 0
+	}()
+
+	tokens := registry.Tokens()
+	// Length of the longest prefix of the input which is also the prefix of a valid type
+	l := 0
+	for l < m.pe && hasTypePrefix(registry, tokens, m.data[:l+1]) {
+		l++
+	}
+	_, complete := registry.Lookup(string(m.data[:l]))
+	m.p = l
+
+	switch {
+	case l == m.pe:
+		if l == 0 || complete {
+			return nil
+		}
+		return m.emitErrorOnPreviousCharacter(ErrTypeIncomplete)
+	case complete && (m.data[l] == '(' || m.data[l] == '!' || m.data[l] == ':'):
+		return nil
+	case complete:
+		return m.emitErrorOnCurrentCharacter(ErrColon)
+	default:
+		return m.emitErrorOnCurrentCharacter(ErrType)
+	}
+}
+
+// checkTypePattern checks the type at the beginning of the input against the type pattern.
+//
+// The type ends at the first '(', '!', or ':' character of the header.
+// Like checkType, it leaves the headers without such a character to the machine.
+func (m *machine) checkTypePattern() error {
+	defer func() {
+		m.p = 0
+	}()
+
+	l := bytes.IndexAny(m.data, "(!:\n")
+	if l <= 0 || m.data[l] == '\n' {
+		return nil
+	}
+	if t := string(m.data[:l]); !m.typePattern.MatchString(t) {
+		return m.emitErrorAt(conventionalcommits.Span{Start: 0, End: l}, ErrTypePattern, t, m.typePattern.String(), l)
+	}
+
+	return nil
+}
+
+// hasTypePrefix tells whether the given prefix is the prefix of one of the given tokens of the registry.
+func hasTypePrefix(registry *conventionalcommits.TypeRegistry, tokens []string, prefix []byte) bool {
+	n := len(prefix)
+	for _, t := range tokens {
+		if len(t) >= n && registry.Equal(t[:n], string(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxTypeDistance is the maximum edit distance between an invalid type and the suggested one.
+const maxTypeDistance = 2
+
+// closestType returns the type closest to the invalid one at the beginning of the input, if any is close enough.
+//
+// The invalid type ends at the first '(', '!', ':', white-space, or newline character.
+// When it is the prefix of a single type, that type is the closest one.
+func (m *machine) closestType(input []byte) (string, bool) {
+	typ := strings.ToLower(string(input[:invalidTypeEnd(input)]))
+	if typ == "" {
+		return "", false
+	}
+	if completions := m.CompleteTypes(typ); len(completions) == 1 {
+		return completions[0], true
+	}
+	types := m.CompleteTypes("")
+
+	best, bestDistance := "", maxTypeDistance+1
+	for _, t := range types {
+		if d := editDistance(typ, strings.ToLower(t)); d < bestDistance && d < len(typ) {
+			best, bestDistance = t, d
+		}
+	}
+
+	return best, best != ""
+}
+
+// invalidTypeEnd returns where the invalid type at the beginning of the input ends.
+func invalidTypeEnd(input []byte) int {
+	end := bytes.IndexAny(input, "(!: \t\n")
+	if end < 0 {
+		return len(input)
+	}
+	return end
+}
+
+// editDistance returns the number of insertions, deletions, substitutions, and transpositions of adjacent characters
+// turning a into b (ie., their optimal string alignment distance).
+func editDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(x)][len(y)]
+}
+
+// min returns the smallest of the given numbers.
+func min(n int, others ...int) int {
+	for _, o := range others {
+		if o < n {
+			n = o
+		}
+	}
+	return n
+}
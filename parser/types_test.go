@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMachineParseWithTypeRegistryOfBuiltinTypes(t *testing.T) {
+	runner(t, "minimaltypes/registry", testCases, WithTypeRegistry(conventionalcommits.TypesMinimal.Registry()))
+	runner(t, "conventionaltypes/registry", testCasesForConventionalTypes, WithTypeRegistry(conventionalcommits.TypesConventional.Registry()))
+}
+
+func TestMachineParseWithTypeRegistry(t *testing.T) {
+	registry := conventionalcommits.NewTypeRegistry(
+		conventionalcommits.TypeDefinition{Name: "feat", Aliases: []string{"feature"}},
+		conventionalcommits.TypeDefinition{Name: "fix"},
+		conventionalcommits.TypeDefinition{Name: "fixup"},
+		conventionalcommits.TypeDefinition{Name: "Infra"},
+	)
+
+	cases := []struct {
+		input       string
+		typ         string
+		errorString string
+	}{
+		{"feat: x", "feat", ""},
+		{"feature(scope)!: x", "feat", ""},
+		{"fixup: x", "fixup", ""},
+		{"Infra: x", "Infra", ""},
+		{"infra: x", "", fmt.Sprintf(ErrType+ColumnPositionTemplate, "i", 0)},
+		{"FIX: x", "", fmt.Sprintf(ErrType+ColumnPositionTemplate, "F", 0)},
+		{"fixu: x", "", fmt.Sprintf(ErrType+ColumnPositionTemplate, ":", 4)},
+		{"fixu", "", fmt.Sprintf(ErrTypeIncomplete+ColumnPositionTemplate, "u", 4)},
+		{"feat x", "", fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 4)},
+		{"fix", "", fmt.Sprintf(ErrEarly+ColumnPositionTemplate, "x", 2)},
+		{"deps: x", "", fmt.Sprintf(ErrType+ColumnPositionTemplate, "d", 0)},
+	}
+
+	for _, tc := range cases {
+		res, err := NewMachine(WithTypeRegistry(registry)).Parse([]byte(tc.input))
+		if tc.errorString != "" {
+			assert.Nil(t, res, tc.input)
+			assert.EqualError(t, err, tc.errorString, tc.input)
+		} else {
+			assert.Nil(t, err, tc.input)
+			assert.Equal(t, tc.typ, res.(*conventionalcommits.ConventionalCommit).Type, tc.input)
+		}
+	}
+}
+
+func TestMachineParseWithCaseInsensitiveTypeRegistry(t *testing.T) {
+	registry := conventionalcommits.NewTypeRegistry(conventionalcommits.TypeDefinition{Name: "Infra"}).CaseInsensitive()
+
+	res, err := NewMachine(WithTypeRegistry(registry)).Parse([]byte("INFRA: x"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Infra", res.(*conventionalcommits.ConventionalCommit).Type)
+}
+
+func TestTypeRegistryPrecedence(t *testing.T) {
+	registry := conventionalcommits.NewTypeRegistry(conventionalcommits.TypeDefinition{Name: "deps"})
+
+	res, err := NewMachine(WithTypeRegistry(registry), WithTypes(conventionalcommits.TypesConventional)).Parse([]byte("deps: x"))
+	assert.Nil(t, err)
+	assert.Equal(t, "deps", res.(*conventionalcommits.ConventionalCommit).Type)
+}
+
+func TestMachineParseWithCustomTypes(t *testing.T) {
+	p := NewMachine(WithCustomTypes([]string{"feat", "fix", "infra", "deps"}))
+
+	res, err := p.Parse([]byte("deps(go): bump logrus"))
+	assert.Nil(t, err)
+	assert.Equal(t, "deps", res.(*conventionalcommits.ConventionalCommit).Type)
+
+	res, err = p.Parse([]byte("docs: x"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "o", 1))
+}
+
+func TestMachineParseWithCaseInsensitiveTypes(t *testing.T) {
+	p := NewMachine(WithCaseInsensitiveTypes(), WithCustomTypes([]string{"feat", "fix"}))
+
+	res, err := p.Parse([]byte("FEAT: foo"))
+	assert.Nil(t, err)
+	assert.Equal(t, "feat", res.(*conventionalcommits.ConventionalCommit).Type)
+
+	res, err = p.Parse([]byte("Fix: bar"))
+	assert.Nil(t, err)
+	assert.Equal(t, "fix", res.(*conventionalcommits.ConventionalCommit).Type)
+
+	res, err = p.Parse([]byte("FEAX: foo"))
+	assert.Nil(t, res)
+	assert.EqualError(t, err, fmt.Sprintf(ErrType+ColumnPositionTemplate, "X", 3))
+
+	// The registry itself is left untouched
+	registry := conventionalcommits.NewTypeRegistryOf("feat")
+	NewMachine(WithTypeRegistry(registry), WithCaseInsensitiveTypes()).Parse([]byte("FEAT: foo"))
+	assert.False(t, registry.IsCaseInsensitive())
+}
+
+func TestMachineParseWithTypePattern(t *testing.T) {
+	pattern := regexp.MustCompile(`^[a-z]{2,10}$`)
+
+	cases := []struct {
+		input       string
+		typ         string
+		errorString string
+	}{
+		{"deps: x", "deps", ""},
+		{"ci(go)!: x", "ci", ""},
+		{"a: x", "", fmt.Sprintf(ErrTypePattern+ColumnPositionTemplate, "a", pattern, 1)},
+		{"Fix(x): x", "", fmt.Sprintf(ErrTypePattern+ColumnPositionTemplate, "Fix", pattern, 3)},
+		{"verylongtype: x", "", fmt.Sprintf(ErrTypePattern+ColumnPositionTemplate, "verylongtype", pattern, 12)},
+		{"fix", "", fmt.Sprintf(ErrEarly+ColumnPositionTemplate, "x", 2)},
+		{"fix\n\nbody (x)", "", fmt.Sprintf(ErrColon+ColumnPositionTemplate, "\n", 3)},
+	}
+
+	for _, tc := range cases {
+		res, err := NewMachine(WithTypePattern(pattern), WithTypes(conventionalcommits.TypesConventional)).Parse([]byte(tc.input))
+		if tc.errorString != "" {
+			assert.Nil(t, res, tc.input)
+			assert.EqualError(t, err, tc.errorString, tc.input)
+		} else {
+			assert.Nil(t, err, tc.input)
+			assert.Equal(t, tc.typ, res.(*conventionalcommits.ConventionalCommit).Type, tc.input)
+		}
+	}
+
+	d := newMachine(WithTypePattern(pattern)).ParseResult([]byte("Fix: x")).Diagnostics()
+	assert.Equal(t, "type-pattern", d[0].Code)
+	assert.Equal(t, conventionalcommits.Span{Start: 0, End: 3}, d[0].Range)
+}
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reviewpad/go-conventionalcommits"
+)
+
+// DefaultWrapColumn is the column WrapBody wraps the body at, when none is given, as git recommends.
+const DefaultWrapColumn = 72
+
+// listItemRegexp matches the lines starting a list item (eg., "- item", "* item", or "1. item").
+var listItemRegexp = regexp.MustCompile(`^([-*+]|\d+[.)])\s+`)
+
+// WrapBody re-emits a commit message with its body wrapped at the given column (DefaultWrapColumn when not positive),
+// once a machine configured with the given options accepts it.
+//
+// It leaves the header and the footer trailers untouched, byte by byte.
+// It preserves the paragraphs of the body and its list items, which it wraps with a hanging indent,
+// and it leaves the indented lines, like code, as they are.
+// It never breaks the words longer than the column, like URLs.
+// It returns the input as is, with the error, when the machine rejects it.
+func WrapBody(input []byte, column int, options ...conventionalcommits.MachineOption) ([]byte, error) {
+	res := newMachine(options...).ParseResult(input)
+	if err := res.Err(); err != nil {
+		return input, err
+	}
+	if column <= 0 {
+		column = DefaultWrapColumn
+	}
+	body := res.SourceMap.Body
+	if body.Len() == 0 {
+		return input, nil
+	}
+
+	paragraphs := strings.Split(string(body.Text(input)), "\n\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = wrapParagraph(p, column)
+	}
+
+	return conventionalcommits.Splice(input, conventionalcommits.Edit{Span: body, Text: strings.Join(paragraphs, "\n\n")})
+}
+
+// wrapParagraph wraps the given paragraph at the given column, item by item when it is a list.
+func wrapParagraph(p string, column int) string {
+	out := []string{}
+	block, indent := []string{}, ""
+	flush := func() {
+		if len(block) > 0 {
+			out = append(out, wrapWords(strings.Fields(strings.Join(block, " ")), column, indent)...)
+		}
+		block, indent = []string{}, ""
+	}
+
+	for _, line := range strings.Split(p, "\n") {
+		switch {
+		case line == "" || line[0] == ' ' || line[0] == '\t':
+			// Keep the indented lines, like code, as they are
+			flush()
+			out = append(out, line)
+		case listItemRegexp.MatchString(line):
+			flush()
+			indent = strings.Repeat(" ", len(listItemRegexp.FindString(line)))
+			block = append(block, line)
+		default:
+			block = append(block, line)
+		}
+	}
+	flush()
+
+	return strings.Join(out, "\n")
+}
+
+// wrapWords joins the given words into lines no longer than the given column, when possible,
+// indenting all the lines but the first one.
+func wrapWords(words []string, column int, indent string) []string {
+	lines := []string{}
+	line := ""
+	for _, w := range words {
+		switch {
+		case line == "":
+			line = w
+		case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(w) > column:
+			lines = append(lines, line)
+			line = indent + w
+		default:
+			line += " " + w
+		}
+	}
+
+	return append(lines, line)
+}
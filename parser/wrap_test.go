@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapBody(t *testing.T) {
+	long := "a long line of the body that goes well beyond the column git recommends for commit messages"
+	url := "https://example.com/" + strings.Repeat("x", 80)
+	cases := []struct {
+		input  string
+		column int
+		output string
+	}{
+		{"fix: x", 0, "fix: x"},
+		{"fix: x\n\n" + long, 0, "fix: x\n\na long line of the body that goes well beyond the column git recommends\nfor commit messages"},
+		{"fix: x\n\nshort\nlines\n\nof text", 0, "fix: x\n\nshort lines\n\nof text"},
+		{"fix: x\n\none two three four", 9, "fix: x\n\none two\nthree\nfour"},
+		{"fix: x\n\nsee " + url + " now", 20, "fix: x\n\nsee\n" + url + "\nnow"},
+		{"fix: x\n\nlist:\n- one two three\n- four", 10, "fix: x\n\nlist:\n- one two\n  three\n- four"},
+		{"fix: x\n\ncode:\n    a b c d e f g\n", 5, "fix: x\n\ncode:\n    a b c d e f g\n"},
+		{"fix: a header longer than the column\n\nbody\n\nRefs: a value longer than the column", 5, "fix: a header longer than the column\n\nbody\n\nRefs: a value longer than the column"},
+	}
+	for _, tc := range cases {
+		out, err := WrapBody([]byte(tc.input), tc.column)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.output, string(out), tc.input)
+	}
+
+	out, err := WrapBody([]byte("fix x"), 0)
+	assert.EqualError(t, err, fmt.Sprintf(ErrColon+ColumnPositionTemplate, " ", 3))
+	assert.Equal(t, "fix x", string(out))
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestResultYAML(t *testing.T) {
+	res := newMachine().ParseResult([]byte("feat(api)!: add endpoint\n\nbody\n\nRefs: #1\nAcked-by: Y"))
+	out, err := yaml.Marshal(res)
+	assert.Nil(t, err)
+	assert.Equal(t, `ok: true
+completeness: full
+type: feat
+scope: api
+breaking: true
+description: add endpoint
+body: body
+footers:
+    - token: refs
+      value: '#1'
+    - token: acked-by
+      value: "Y"
+`, string(out))
+
+	// It embeds into other documents
+	out, err = yaml.Marshal(map[string]interface{}{"commit": newMachine().ParseResult([]byte("fix x"))})
+	assert.Nil(t, err)
+	assert.Equal(t, `commit:
+    ok: false
+    completeness: none
+    diagnostics:
+        - code: colon
+          severity: error
+          message: expecting colon (':') character, got ' ' character
+          line: 1
+          column: 4
+          suggestion: insert ':' after 'fix'
+`, string(out))
+}
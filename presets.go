@@ -0,0 +1,108 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// TypesUnknown is the type config Lookup returns for unknown presets.
+//
+// Its registry is empty, so that the parser rejects any type rather than silently falling back to another set.
+const TypesUnknown TypeConfig = -1
+
+// presets contains the type sets registered at runtime.
+var presets = struct {
+	sync.RWMutex
+	names      map[string]TypeConfig
+	registries map[TypeConfig]*TypeRegistry
+	next       TypeConfig
+}{
+	names: map[string]TypeConfig{
+		"minimal":      TypesMinimal,
+		"conventional": TypesConventional,
+		"free-form":    TypesFreeForm,
+	},
+	registries: map[TypeConfig]*TypeRegistry{},
+	next:       TypesFreeForm + 1,
+}
+
+// RegisterTypeConfig registers a named set of types, and returns the type config selecting it.
+//
+// It lets downstream libraries ship their own presets without regenerating the parser.
+// Registering a name again replaces its types, keeping the same type config.
+// It panics when the name is the one of a built-in set, or when the registry is nil.
+func RegisterTypeConfig(name string, r *TypeRegistry) TypeConfig {
+	if r == nil {
+		panic("conventionalcommits: nil registry for preset " + name)
+	}
+	presets.Lock()
+	defer presets.Unlock()
+
+	t, ok := presets.names[name]
+	if ok && t.IsBuiltin() {
+		panic(fmt.Sprintf("conventionalcommits: cannot replace the built-in preset %s", name))
+	}
+	if !ok {
+		t = presets.next
+		presets.next++
+		presets.names[name] = t
+	}
+	presets.registries[t] = r.Clone()
+
+	return t
+}
+
+// UnregisterTypeConfig removes the named set of types registered at runtime, if any,
+// so that the type config selecting it behaves like TypesUnknown (eg., to clean up after tests).
+//
+// It tells whether there was such a set, and does nothing for the built-in sets.
+func UnregisterTypeConfig(name string) bool {
+	presets.Lock()
+	defer presets.Unlock()
+
+	t, ok := presets.names[name]
+	if !ok || t.IsBuiltin() {
+		return false
+	}
+	delete(presets.names, name)
+	delete(presets.registries, t)
+
+	return true
+}
+
+// Lookup returns the type config with the given name, built-in or registered.
+//
+// It returns TypesUnknown when there is no such preset.
+func Lookup(name string) TypeConfig {
+	presets.RLock()
+	defer presets.RUnlock()
+
+	if t, ok := presets.names[name]; ok {
+		return t
+	}
+	return TypesUnknown
+}
+
+// IsBuiltin tells whether the receiving type config is one of the sets built in the parser.
+func (t TypeConfig) IsBuiltin() bool {
+	return t >= TypesMinimal && t <= TypesFreeForm
+}
+
+// registeredPresets returns the capabilities of the presets registered at runtime.
+func registeredPresets() []Capability {
+	presets.RLock()
+	defer presets.RUnlock()
+
+	list := []Capability{}
+	for name, t := range presets.names {
+		if !t.IsBuiltin() {
+			list = append(list, Capability{Name: name, Version: "1"})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
@@ -0,0 +1,316 @@
+package conventionalcommits
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// The numbers of the fields of the ConventionalCommit message in proto/conventional_commit.proto.
+const (
+	protoType           = 1
+	protoDescription    = 2
+	protoScope          = 3
+	protoMultipleScopes = 4
+	protoScopePath      = 5
+	protoExclamation    = 6
+	protoBody           = 7
+	protoFooters        = 8
+	protoAnnotations    = 9
+)
+
+// The wire types of the protobuf encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// The wire types of the fields of the protobuf messages, by their number.
+var (
+	protoCommitWireTypes = map[int]int{
+		protoType:           wireBytes,
+		protoDescription:    wireBytes,
+		protoScope:          wireBytes,
+		protoMultipleScopes: wireBytes,
+		protoScopePath:      wireBytes,
+		protoExclamation:    wireVarint,
+		protoBody:           wireBytes,
+		protoFooters:        wireBytes,
+		protoAnnotations:    wireBytes,
+	}
+	protoFooterWireTypes = map[int]int{1: wireBytes, 2: wireBytes, 3: wireBytes}
+	protoPairWireTypes   = map[int]int{1: wireBytes, 2: wireBytes}
+)
+
+var (
+	// errProtoTruncated tells that the protobuf encoding ends in the middle of a field.
+	errProtoTruncated = errors.New("truncated protobuf message")
+	// errProtoInvalidUTF8 tells that a string field is not valid UTF-8, as proto3 requires.
+	errProtoInvalidUTF8 = errors.New("protobuf string field containing invalid UTF-8")
+)
+
+// protoEncoder appends the fields of a protobuf message in the wire format.
+//
+// It records the first error, so that the callers check it once at the end.
+type protoEncoder struct {
+	buf []byte
+	err error
+}
+
+func (e *protoEncoder) varint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	e.buf = append(e.buf, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func (e *protoEncoder) bytes(field int, b []byte) {
+	e.varint(uint64(field)<<3 | wireBytes)
+	e.varint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *protoEncoder) string(field int, s string) {
+	if !utf8.ValidString(s) && e.err == nil {
+		e.err = errProtoInvalidUTF8
+	}
+	e.bytes(field, []byte(s))
+}
+
+// message appends the given embedded message, and its error, if any.
+func (e *protoEncoder) message(field int, m protoEncoder) {
+	if m.err != nil && e.err == nil {
+		e.err = m.err
+	}
+	e.bytes(field, m.buf)
+}
+
+// protoString decodes a string field, which must be valid UTF-8.
+func protoString(b []byte) (string, error) {
+	if !utf8.Valid(b) {
+		return "", errProtoInvalidUTF8
+	}
+	return string(b), nil
+}
+
+// MarshalProto encodes the receiving commit message as the ConventionalCommit protobuf message of proto/conventional_commit.proto,
+// so that services can exchange it over gRPC.
+//
+// The footer trailers keep their order, and the fields follow the order of their numbers, like the protobuf runtime does.
+// It fails when a string is not valid UTF-8, as proto3 requires.
+func (c *ConventionalCommit) MarshalProto() ([]byte, error) {
+	e := protoEncoder{}
+	if c.Type != "" {
+		e.string(protoType, c.Type)
+	}
+	if c.Description != "" {
+		e.string(protoDescription, c.Description)
+	}
+	if c.Scope != nil {
+		e.string(protoScope, *c.Scope)
+	}
+	for _, s := range c.MultipleScopes {
+		e.string(protoMultipleScopes, s)
+	}
+	for _, s := range c.ScopePath {
+		e.string(protoScopePath, s)
+	}
+	if c.Exclamation {
+		e.varint(protoExclamation<<3 | wireVarint)
+		e.varint(1)
+	}
+	if c.Body != nil {
+		e.string(protoBody, *c.Body)
+	}
+	footers := c.OrderedFooters
+	if len(footers) == 0 {
+		footers = sortedFooters(c.Footers)
+	}
+	for _, f := range footers {
+		entry := protoEncoder{}
+		if f.Token != "" {
+			entry.string(1, f.Token)
+		}
+		if f.Value != "" {
+			entry.string(2, f.Value)
+		}
+		if f.Separator != "" {
+			entry.string(3, f.Separator)
+		}
+		e.message(protoFooters, entry)
+	}
+	keys := make([]string, 0, len(c.Annotations))
+	for k := range c.Annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		entry := protoEncoder{}
+		entry.string(1, k)
+		entry.string(2, c.Annotations[k])
+		e.message(protoAnnotations, entry)
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	return e.buf, nil
+}
+
+// UnmarshalProto decodes a commit message encoded as the ConventionalCommit protobuf message of proto/conventional_commit.proto.
+//
+// It skips the unknown fields, and builds the Footers map from the footer trailers.
+// It fails when a known field has an unexpected wire type, or a string is not valid UTF-8.
+func UnmarshalProto(data []byte) (*ConventionalCommit, error) {
+	c := &ConventionalCommit{}
+	err := walkProto(data, protoCommitWireTypes, func(field int, v uint64, b []byte) error {
+		if field == protoExclamation {
+			c.Exclamation = v != 0
+			return nil
+		}
+		if field == protoFooters {
+			f, err := unmarshalProtoFooter(b)
+			if err != nil {
+				return err
+			}
+			if c.Footers == nil {
+				c.Footers = map[string][]string{}
+			}
+			key := FooterKey(f.Token)
+			c.Footers[key] = append(c.Footers[key], f.Value)
+			c.OrderedFooters = append(c.OrderedFooters, f)
+			return nil
+		}
+		if field == protoAnnotations {
+			key, value, err := unmarshalProtoPair(b)
+			if err != nil {
+				return err
+			}
+			c.Annotate(key, value)
+			return nil
+		}
+
+		s, err := protoString(b)
+		if err != nil {
+			return err
+		}
+		switch field {
+		case protoType:
+			c.Type = s
+		case protoDescription:
+			c.Description = s
+		case protoScope:
+			c.Scope = &s
+		case protoMultipleScopes:
+			c.MultipleScopes = append(c.MultipleScopes, s)
+		case protoScopePath:
+			c.ScopePath = append(c.ScopePath, s)
+		case protoBody:
+			c.Body = &s
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// unmarshalProtoFooter decodes the footer trailers.
+func unmarshalProtoFooter(data []byte) (Footer, error) {
+	f := Footer{}
+	err := walkProto(data, protoFooterWireTypes, func(field int, _ uint64, b []byte) error {
+		s, err := protoString(b)
+		if err != nil {
+			return err
+		}
+		switch field {
+		case 1:
+			f.Token = s
+		case 2:
+			f.Value = s
+		case 3:
+			f.Separator = s
+		}
+		return nil
+	})
+	return f, err
+}
+
+// unmarshalProtoPair decodes the messages made of two strings, like the map entries.
+func unmarshalProtoPair(data []byte) (string, string, error) {
+	var first, second string
+	err := walkProto(data, protoPairWireTypes, func(field int, _ uint64, b []byte) error {
+		s, err := protoString(b)
+		if err != nil {
+			return err
+		}
+		if field == 1 {
+			first = s
+		} else {
+			second = s
+		}
+		return nil
+	})
+	return first, second, err
+}
+
+// walkProto calls the given function with the number and the value of every known field of the given protobuf message,
+// either the varint or the bytes, depending on its wire type, which must be the one the given wire types tell.
+//
+// It skips the unknown fields.
+func walkProto(data []byte, wireTypes map[int]int, f func(field int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errProtoTruncated
+		}
+		data = data[n:]
+
+		var v uint64
+		var b []byte
+		switch tag & 7 {
+		case wireVarint:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errProtoTruncated
+			}
+			data = data[n:]
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < l {
+				return errProtoTruncated
+			}
+			b = data[n : n+int(l)]
+			data = data[n+int(l):]
+		case wireFixed64, wireFixed32:
+			size := 8
+			if tag&7 == wireFixed32 {
+				size = 4
+			}
+			if len(data) < size {
+				return errProtoTruncated
+			}
+			data = data[size:]
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", tag&7)
+		}
+
+		field := int(tag >> 3)
+		expected, known := wireTypes[field]
+		if !known {
+			continue
+		}
+		if int(tag&7) != expected {
+			return fmt.Errorf("protobuf field %d with wire type %d, expecting %d", field, tag&7, expected)
+		}
+		if err := f(field, v, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
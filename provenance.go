@@ -0,0 +1,74 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// Provenance trailer tokens, as they are in the Footers map.
+const (
+	// FooterProvenance links a commit to its provenance (eg., an in-toto statement).
+	FooterProvenance = "provenance"
+	// FooterSLSABuild links a commit to the SLSA build that produced its artifacts.
+	FooterSLSABuild = "slsa-build"
+	// FooterAttestation links a commit to an attestation.
+	FooterAttestation = "attestation"
+)
+
+var provenanceTokens = []string{FooterProvenance, FooterSLSABuild, FooterAttestation}
+
+var digestRegexp = regexp.MustCompile(`^[a-z0-9]+:[a-fA-F0-9]{32,}$`)
+
+// ProvenanceReference represents the value of a provenance-related trailer.
+//
+// The value is either an absolute URL or a digest (eg., "sha256:<hex>") identifying an attestation.
+type ProvenanceReference struct {
+	Token  string
+	Value  string
+	URL    *url.URL // set when the value is an URL
+	Digest string   // set when the value is a digest
+}
+
+// ParseProvenanceReference parses the value of the provenance-related trailer with the given token.
+func ParseProvenanceReference(token, value string) (ProvenanceReference, error) {
+	ref := ProvenanceReference{Token: token, Value: value}
+	if digestRegexp.MatchString(value) {
+		ref.Digest = value
+		return ref, nil
+	}
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return ref, fmt.Errorf("invalid %s trailer value '%s': expecting an absolute URL or a digest", token, value)
+	}
+	ref.URL = u
+
+	return ref, nil
+}
+
+// Provenance returns the references of the provenance-related trailers of the receiving commit message.
+//
+// It skips the invalid ones, use ValidateProvenance to detect them.
+func (c *ConventionalCommit) Provenance() []ProvenanceReference {
+	refs := []ProvenanceReference{}
+	for _, token := range provenanceTokens {
+		for _, value := range c.Footers[token] {
+			if ref, err := ParseProvenanceReference(token, value); err == nil {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// ValidateProvenance returns an error when a provenance-related trailer of the receiving commit message is invalid.
+func (c *ConventionalCommit) ValidateProvenance() error {
+	for _, token := range provenanceTokens {
+		for _, value := range c.Footers[token] {
+			if _, err := ParseProvenanceReference(token, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
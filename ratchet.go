@@ -0,0 +1,72 @@
+package conventionalcommits
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Metrics aggregates figures about a set of commit messages, like the ones of a pull request or of a branch.
+type Metrics struct {
+	// Total is the number of commit messages.
+	Total int
+	// Compliant is the number of valid commit messages.
+	Compliant int
+	// HeaderCharacters is the number of characters of all the first lines.
+	HeaderCharacters int
+}
+
+// Add accounts for the given commit message and the result of parsing it.
+func (m *Metrics) Add(input []byte, r Result) {
+	m.Total++
+	if r.Ok() {
+		m.Compliant++
+	}
+	header := input
+	if i := bytes.IndexByte(input, '\n'); i >= 0 {
+		header = input[:i]
+	}
+	m.HeaderCharacters += utf8.RuneCount(header)
+}
+
+// Compliance returns the ratio of valid commit messages, which is 1 when there are none.
+func (m Metrics) Compliance() float64 {
+	if m.Total == 0 {
+		return 1
+	}
+	return float64(m.Compliant) / float64(m.Total)
+}
+
+// AverageHeaderLength returns the average number of characters of the first lines.
+func (m Metrics) AverageHeaderLength() float64 {
+	if m.Total == 0 {
+		return 0
+	}
+	return float64(m.HeaderCharacters) / float64(m.Total)
+}
+
+// Ratchet tells which metrics can only improve over time.
+//
+// It encourages the gradual adoption of the rules: integrations compare the metrics before and after every change,
+// and reject the changes making them worse.
+type Ratchet struct {
+	// Compliance, when true, requires the compliance not to decrease.
+	Compliance bool
+	// HeaderLength, when true, requires the average header length not to grow.
+	HeaderLength bool
+}
+
+// DefaultRatchet ratchets all the metrics.
+var DefaultRatchet = Ratchet{Compliance: true, HeaderLength: true}
+
+// Check returns an error when the current metrics are worse than the previous ones.
+func (r Ratchet) Check(previous, current Metrics) error {
+	if r.Compliance && current.Compliance() < previous.Compliance() {
+		return fmt.Errorf("compliance decreased from %.2f%% to %.2f%%", previous.Compliance()*100, current.Compliance()*100)
+	}
+	if r.HeaderLength && current.AverageHeaderLength() > previous.AverageHeaderLength() {
+		return fmt.Errorf("average header length grew from %.2f to %.2f characters", previous.AverageHeaderLength(), current.AverageHeaderLength())
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package conventionalcommits
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Reference is a reference to an issue, or to a pull request, in a footer trailer (eg., "Refs: owner/repo#12").
+type Reference struct {
+	// Token is the key of the footer trailer in the Footers map.
+	Token string `json:"token"`
+	// Owner and Repository are set when the reference names the repository of the issue.
+	Owner      string `json:"owner,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	Issue      string `json:"issue"`
+	// URL is set when the reference is a link to the issue.
+	URL string `json:"url,omitempty"`
+	// Raw is the text of the reference in the footer trailer value.
+	Raw string `json:"raw"`
+}
+
+var (
+	urlRegexp            = regexp.MustCompile(`https?://\S+`)
+	issueURLRegexp       = regexp.MustCompile(`^https?://[^/\s]+/([\w.-]+)/([\w.-]+)/(?:-/)?(?:issues|pull|pulls|merge_requests)/(\d+)`)
+	shortReferenceRegexp = regexp.MustCompile(`(?:\b([\w.-]+)/([\w.-]+))?#(\d+)\b`)
+)
+
+// ParseReferences decomposes the given value of a footer trailer with the given token into the references it contains,
+// in order.
+//
+// It recognizes the issue numbers (eg., "#12"), the ones of other repositories (eg., "owner/repo#12"),
+// and the links to the issues, the pull requests, and the merge requests of GitHub and GitLab
+// (eg., "https://github.com/owner/repo/issues/1#issuecomment-2").
+// It ignores the other links, and the fragments of the links, even when they look like issue numbers.
+func ParseReferences(token, value string) []Reference {
+	type located struct {
+		at int
+		r  Reference
+	}
+	found := []located{}
+	key := FooterKey(token)
+
+	// The links go first, so that their fragments are not taken for issue numbers
+	for _, loc := range urlRegexp.FindAllStringIndex(value, -1) {
+		link := strings.TrimRight(value[loc[0]:loc[1]], ".,;:)")
+		if m := issueURLRegexp.FindStringSubmatch(link); m != nil {
+			found = append(found, located{loc[0], Reference{Token: key, Owner: m[1], Repository: m[2], Issue: m[3], URL: link, Raw: link}})
+		}
+		value = value[:loc[0]] + strings.Repeat(" ", loc[1]-loc[0]) + value[loc[1]:]
+	}
+	for _, m := range shortReferenceRegexp.FindAllStringSubmatchIndex(value, -1) {
+		r := Reference{Token: key, Issue: value[m[6]:m[7]], Raw: value[m[0]:m[1]]}
+		if m[2] >= 0 {
+			r.Owner, r.Repository = value[m[2]:m[3]], value[m[4]:m[5]]
+		}
+		found = append(found, located{m[0], r})
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].at < found[j].at
+	})
+
+	refs := make([]Reference, len(found))
+	for i, l := range found {
+		refs[i] = l.r
+	}
+	return refs
+}
+
+// Verbatim returns the value of the receiving footer trailer as written,
+// with the '#' of the " #" separator (eg., "#12 and #13" for "Fixes #12 and #13").
+//
+// The Value field, like the values of the Footers map, does not include it, as it never did,
+// so that the value of "Refs #133" stays "133" for the existing callers.
+func (f Footer) Verbatim() string {
+	if strings.HasSuffix(f.Separator, "#") {
+		return "#" + f.Value
+	}
+	return f.Value
+}
+
+// VerbatimFooter returns the values of the trailers of the receiving commit message with the given token, as written
+// (see Footer.Verbatim), in order.
+//
+// Like Footer, the token is case-insensitive.
+// Without the ordered footers, it returns the values of the Footers map.
+func (c *ConventionalCommit) VerbatimFooter(token string) []string {
+	if len(c.OrderedFooters) == 0 {
+		return c.Footer(token)
+	}
+	values := []string{}
+	for _, f := range c.OrderedFooters {
+		if FooterKey(f.Token) == FooterKey(token) {
+			values = append(values, f.Verbatim())
+		}
+	}
+	return values
+}
+
+// References returns the references to issues in the footer trailers of the receiving commit message, in order,
+// including the issue number after the " #" separator (eg., both "#12" and "#13" for "Fixes #12 and #13").
+func (c *ConventionalCommit) References() []Reference {
+	footers := c.OrderedFooters
+	if len(footers) == 0 {
+		footers = sortedFooters(c.Footers)
+	}
+	refs := []Reference{}
+	for _, f := range footers {
+		refs = append(refs, ParseReferences(f.Token, f.Verbatim())...)
+	}
+	return refs
+}
@@ -0,0 +1,56 @@
+package conventionalcommits
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Release note trailer tokens, as they are in the Footers map.
+const (
+	// FooterReleaseNote overrides the changelog entry of a commit.
+	FooterReleaseNote = "release-note"
+	// FooterNote overrides the changelog entry of a commit, with less precedence than FooterReleaseNote.
+	FooterNote = "note"
+)
+
+var (
+	releaseNoteBlockRegexp     = regexp.MustCompile("(?s)```release-note\\n(.*?)\\n?```")
+	releaseNoteParagraphRegexp = regexp.MustCompile(`(?s)(?:^|\n\n)NOTE:[ \t]*(.*?)(?:\n\n|$)`)
+)
+
+// ReleaseNote returns the text its author wants in the changelog for the receiving commit message, if any.
+//
+// In order of precedence, it looks for a Release-note trailer, a Kubernetes-style "```release-note" block
+// in the body, a NOTE trailer, and a body paragraph starting with "NOTE:".
+// A "NONE" release note (regardless of its case) tells that the commit deserves no changelog entry:
+// then, the note is empty and the boolean is true.
+func (c *ConventionalCommit) ReleaseNote() (string, bool) {
+	note, ok := c.releaseNote()
+	if !ok {
+		return "", false
+	}
+	if strings.EqualFold(note, "none") {
+		return "", true
+	}
+	return note, true
+}
+
+func (c *ConventionalCommit) releaseNote() (string, bool) {
+	if values := c.Footers[FooterReleaseNote]; len(values) > 0 {
+		return strings.TrimSpace(values[0]), true
+	}
+	body := ""
+	if c.Body != nil {
+		body = *c.Body
+	}
+	if m := releaseNoteBlockRegexp.FindStringSubmatch(body); m != nil {
+		return strings.TrimSpace(m[1]), true
+	}
+	if values := c.Footers[FooterNote]; len(values) > 0 {
+		return strings.TrimSpace(values[0]), true
+	}
+	if m := releaseNoteParagraphRegexp.FindStringSubmatch(body); m != nil {
+		return strings.TrimSpace(m[1]), true
+	}
+	return "", false
+}
@@ -0,0 +1,97 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangelogGroup is a group of changelog entries of the same type, or of the same section for the hand-written ones.
+type ChangelogGroup struct {
+	Title   string           `json:"title"`
+	Entries []ChangelogEntry `json:"entries"`
+}
+
+// GroupChangelogEntries groups the given entries by type, or by section for the hand-written ones,
+// in the order the groups first appear.
+func GroupChangelogEntries(entries []ChangelogEntry) []ChangelogGroup {
+	groups := []ChangelogGroup{}
+	index := map[string]int{}
+	for _, e := range entries {
+		title := e.Type
+		if e.Section != "" {
+			title = e.Section
+		}
+		i, ok := index[title]
+		if !ok {
+			i = len(groups)
+			index[title] = i
+			groups = append(groups, ChangelogGroup{Title: title, Entries: []ChangelogEntry{}})
+		}
+		groups[i].Entries = append(groups[i].Entries, e)
+	}
+
+	return groups
+}
+
+// RepositoryRelease is the release of a repository in a release train.
+type RepositoryRelease struct {
+	Repository string           `json:"repository"`
+	Version    string           `json:"version"`
+	Groups     []ChangelogGroup `json:"groups"`
+}
+
+// ReleaseTrain is the umbrella release note of the coordinated release of several repositories.
+type ReleaseTrain struct {
+	Version  string              `json:"version"`
+	Releases []RepositoryRelease `json:"releases"`
+}
+
+// NewReleaseTrain aggregates the changelog manifests of the given repositories, keyed by name,
+// into the umbrella release note of the given version.
+//
+// It groups the entries by repository, in alphabetical order, then by type (see GroupChangelogEntries).
+// It skips the repositories without entries.
+func NewReleaseTrain(version string, manifests map[string]ChangelogManifest) ReleaseTrain {
+	t := ReleaseTrain{Version: version, Releases: []RepositoryRelease{}}
+	for repo, m := range manifests {
+		if len(m.Entries) == 0 {
+			continue
+		}
+		t.Releases = append(t.Releases, RepositoryRelease{Repository: repo, Version: m.Version, Groups: GroupChangelogEntries(m.Entries)})
+	}
+	sort.Slice(t.Releases, func(i, j int) bool {
+		return t.Releases[i].Repository < t.Releases[j].Repository
+	})
+
+	return t
+}
+
+// Markdown renders the receiving release train, with a heading for every repository, and for every group of entries.
+func (t ReleaseTrain) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", t.Version)
+	for _, r := range t.Releases {
+		fmt.Fprintf(&b, "\n## %s %s\n", r.Repository, r.Version)
+		for _, g := range r.Groups {
+			fmt.Fprintf(&b, "\n### %s\n\n", g.Title)
+			for _, e := range g.Entries {
+				b.WriteString("- " + markdownEntry(e) + "\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// markdownEntry renders the given changelog entry as Markdown, with its scope in bold, and a mark when breaking.
+func markdownEntry(e ChangelogEntry) string {
+	text := e.Text
+	if e.Scope != "" {
+		text = "**" + e.Scope + ":** " + text
+	}
+	if e.Breaking {
+		text += " (breaking)"
+	}
+	return text
+}
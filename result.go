@@ -0,0 +1,146 @@
+package conventionalcommits
+
+import (
+	"bytes"
+)
+
+// Completeness tells how much of the input a parse result covers.
+type Completeness int
+
+const (
+	// CompletenessNone means that no commit message has been found.
+	CompletenessNone Completeness = iota
+	// CompletenessHeaderOnly means that the parsing stopped after a valid header, ie. type and description.
+	CompletenessHeaderOnly
+	// CompletenessPartial means that the parsing stopped after the header, but some body or footer has been found.
+	CompletenessPartial
+	// CompletenessFull means that the whole input is a valid commit message.
+	CompletenessFull
+)
+
+// String returns the name of the completeness level.
+func (c Completeness) String() string {
+	switch c {
+	case CompletenessHeaderOnly:
+		return "header-only"
+	case CompletenessPartial:
+		return "partial"
+	case CompletenessFull:
+		return "full"
+	default:
+		return "none"
+	}
+}
+
+// Result represents the outcome of parsing a commit message.
+//
+// Differently from the (Message, error) pair, it tells explicitly whether the message is the result
+// of a full parsing or the best effort one, and it carries the warnings about non-fatal issues.
+type Result struct {
+	Message      Message
+	Completeness Completeness
+	Errors       []error
+	Warnings     []error
+	SourceMap    SourceMap
+}
+
+// Ok tells whether the whole input is a valid commit message.
+func (r Result) Ok() bool {
+	return r.Completeness == CompletenessFull && len(r.Errors) == 0
+}
+
+// Err returns the first error, if any.
+func (r Result) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return r.Errors[0]
+}
+
+// IsBreakingChange tells whether the parsed message is a breaking change,
+// either because of the "!" after the type or because of a "BREAKING CHANGE" (or "BREAKING-CHANGE") trailer.
+//
+// It is false when no message has been found.
+func (r Result) IsBreakingChange() bool {
+	return r.Message != nil && r.Message.IsBreakingChange()
+}
+
+// Unpack returns the result in the (Message, error) form returned by Machine.Parse.
+func (r Result) Unpack() (Message, error) {
+	return r.Message, r.Err()
+}
+
+// RawHeader returns the untouched first line of the input, without its newline.
+//
+// Like the other raw accessors, it only works on the results of ParseResult, which know their input, and returns nil otherwise.
+func (r Result) RawHeader() []byte {
+	if r.SourceMap.input == nil {
+		return nil
+	}
+	if i := bytes.IndexByte(r.SourceMap.input, '\n'); i >= 0 {
+		return r.SourceMap.input[:i]
+	}
+	return r.SourceMap.input
+}
+
+// RawBody returns the untouched body, if any.
+func (r Result) RawBody() []byte {
+	if r.SourceMap.input == nil || r.SourceMap.Body.Len() == 0 {
+		return nil
+	}
+	return r.SourceMap.Body.Text(r.SourceMap.input)
+}
+
+// RawFooters returns the untouched block of the footer trailers, from the first token to the end of the last value, if any.
+func (r Result) RawFooters() []byte {
+	footers := r.SourceMap.Footers
+	if r.SourceMap.input == nil || len(footers) == 0 {
+		return nil
+	}
+	return r.SourceMap.input[footers[0].Span.Start:footers[len(footers)-1].Span.End]
+}
+
+// String renders the parsed message as text, or returns an empty string when no message has been found.
+func (r Result) String() string {
+	c, ok := r.Message.(*ConventionalCommit)
+	if !ok || c == nil {
+		return ""
+	}
+	return string(c.Marshal())
+}
+
+// Diagnostics converts the errors and the warnings of the receiving result into diagnostics.
+func (r Result) Diagnostics() []Diagnostic {
+	diagnostics := []Diagnostic{}
+	for _, err := range r.Errors {
+		diagnostics = append(diagnostics, NewDiagnostic(err, SeverityError))
+	}
+	for _, err := range r.Warnings {
+		diagnostics = append(diagnostics, NewDiagnostic(err, SeverityWarning))
+	}
+	return diagnostics
+}
+
+// NewResult creates a Result from the (Message, error) pair returned by Machine.Parse.
+func NewResult(msg Message, err error, warnings ...error) Result {
+	r := Result{Message: msg, Warnings: warnings}
+	if err != nil {
+		r.Errors = []error{err}
+	}
+
+	switch {
+	case msg == nil:
+		r.Completeness = CompletenessNone
+	case err == nil:
+		r.Completeness = CompletenessFull
+	case msg.HasFooter():
+		r.Completeness = CompletenessPartial
+	default:
+		r.Completeness = CompletenessHeaderOnly
+		if c, ok := msg.(*ConventionalCommit); ok && c.Body != nil {
+			r.Completeness = CompletenessPartial
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,42 @@
+package conventionalcommits
+
+import (
+	"strings"
+)
+
+// ReviewTrailers are the people the well-known review trailers of a commit message credit,
+// like the ones of the workflow of the Linux kernel.
+type ReviewTrailers struct {
+	ReviewedBy []Identity `json:"reviewed_by"`
+	AckedBy    []Identity `json:"acked_by"`
+	TestedBy   []Identity `json:"tested_by"`
+	ReportedBy []Identity `json:"reported_by"`
+}
+
+// People returns the people of the trailers with the given token of the receiving commit message, in order.
+//
+// The token is case-insensitive, like in the Footer method.
+// The values in the "Name <email>" format of git become full identities, the other ones identities with a name only
+// (eg., "Reviewed-by: Leo").
+func (c *ConventionalCommit) People(token string) []Identity {
+	out := []Identity{}
+	for _, v := range c.Footer(token) {
+		i, err := ParseIdentity(v)
+		if err != nil {
+			i = Identity{Name: strings.TrimSpace(v)}
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// ReviewTrailers returns the people the "Reviewed-by", "Acked-by", "Tested-by", and "Reported-by" trailers
+// of the receiving commit message credit.
+func (c *ConventionalCommit) ReviewTrailers() ReviewTrailers {
+	return ReviewTrailers{
+		ReviewedBy: c.People("Reviewed-by"),
+		AckedBy:    c.People("Acked-by"),
+		TestedBy:   c.People("Tested-by"),
+		ReportedBy: c.People("Reported-by"),
+	}
+}
@@ -0,0 +1,59 @@
+package conventionalcommits
+
+import "sort"
+
+// Release is a named set of commit messages.
+type Release struct {
+	Name     string
+	Messages []Message
+}
+
+// ScopeReport tells which scopes maintainers can prune from their allowlist.
+type ScopeReport struct {
+	// Unused contains the allowed scopes no commit message used in the last releases.
+	Unused []string
+	// UsedOnce contains the scopes a single commit message used across all the releases.
+	UsedOnce []string
+}
+
+// NewScopeReport analyses the scopes of the given releases, from the oldest to the newest.
+//
+// The unused scopes are the ones of the allowlist missing from the last n releases, or from all of them when n is not positive.
+func NewScopeReport(releases []Release, allowlist []string, n int) ScopeReport {
+	recent := releases
+	if n > 0 && n < len(releases) {
+		recent = releases[len(releases)-n:]
+	}
+	used := map[string]bool{}
+	for _, r := range recent {
+		for _, m := range r.Messages {
+			for _, s := range scopesOf(m) {
+				used[s] = true
+			}
+		}
+	}
+	counts := map[string]int{}
+	for _, r := range releases {
+		for _, m := range r.Messages {
+			for _, s := range scopesOf(m) {
+				counts[s]++
+			}
+		}
+	}
+
+	report := ScopeReport{Unused: []string{}, UsedOnce: []string{}}
+	for _, s := range allowlist {
+		if !used[s] {
+			report.Unused = append(report.Unused, s)
+		}
+	}
+	for s, c := range counts {
+		if c == 1 {
+			report.UsedOnce = append(report.UsedOnce, s)
+		}
+	}
+	sort.Strings(report.Unused)
+	sort.Strings(report.UsedOnce)
+
+	return report
+}
@@ -0,0 +1,179 @@
+package conventionalcommits
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// Span represents the byte range [Start, End) of a part of the input commit message.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Len returns the number of bytes in the span.
+func (s Span) Len() int {
+	return s.End - s.Start
+}
+
+// Text returns the part of the input the span refers to.
+func (s Span) Text(input []byte) []byte {
+	return input[s.Start:s.End]
+}
+
+// Position represents a position in the input commit message.
+type Position struct {
+	// Line is the number of the line, starting from 1.
+	Line int
+	// Column is the number of the character in the line, starting from 1.
+	Column int
+	// Offset is the number of bytes preceding the position.
+	Offset int
+}
+
+// NewPosition computes the line and the column of the given byte offset of the input.
+//
+// Differently from OffsetToLineCol, the column counts characters rather than bytes, and starts from 1.
+// To compute many positions of the same input, use the Position method of its source map instead.
+func NewPosition(input []byte, offset int) Position {
+	return NewSourceMap(input).Position(offset)
+}
+
+// Positioner represents errors able to tell where they occur.
+type Positioner interface {
+	Position() Position
+}
+
+// FooterSpan represents the position of a footer trailer in the input commit message.
+type FooterSpan struct {
+	// Token is the trailer token, as it is in the Footers map of the commit message.
+	Token string
+	// Separator is the text between the trailer token and the trailer value (eg., ": ", or " #").
+	Separator string
+	// Span is the range of the whole trailer.
+	Span Span
+	// TokenSpan is the range of the trailer token.
+	TokenSpan Span
+	// ValueSpan is the range of the trailer value.
+	ValueSpan Span
+}
+
+// SourceMap tells where the parts of a parsed commit message are in the input.
+type SourceMap struct {
+	// Type is the range of the type.
+	Type Span
+	// Scope is the range of the scope, between the parentheses, if any.
+	Scope Span
+	// Description is the range of the description.
+	Description Span
+	// Body is the range of the body, if any.
+	Body Span
+	// Footers contains the footer trailers in the order they appear in the input.
+	Footers []FooterSpan
+
+	// lineStarts contains the offsets where the lines of the input start
+	lineStarts []int
+	// input is the input the source map refers to
+	input []byte
+}
+
+// NewSourceMap creates an empty source map for the given input.
+func NewSourceMap(input []byte) *SourceMap {
+	return &SourceMap{lineStarts: lineStarts(input), input: input}
+}
+
+// OffsetToLineCol translates an offset in the input into a line, starting from 1,
+// and a column, starting from 0 like the columns in the parse errors.
+//
+// A negative offset is the beginning of the input.
+func (s SourceMap) OffsetToLineCol(offset int) (line int, col int) {
+	if offset < 0 {
+		offset = 0
+	}
+	starts := s.lineStarts
+	if len(starts) == 0 {
+		starts = []int{0}
+	}
+	// Index of the first line starting after the offset
+	i := sort.SearchInts(starts, offset+1)
+	return i, offset - starts[i-1]
+}
+
+// Position computes the line and the column of the given byte offset of the input, like NewPosition does.
+//
+// It only works on the source maps created with NewSourceMap, which know their input.
+func (s SourceMap) Position(offset int) Position {
+	if offset > len(s.input) {
+		offset = len(s.input)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	line, col := s.OffsetToLineCol(offset)
+
+	return Position{
+		Line:   line,
+		Column: utf8.RuneCount(s.input[offset-col:offset]) + 1,
+		Offset: offset,
+	}
+}
+
+// OffsetToLineCol translates an offset in the input into a line, starting from 1,
+// and a column, starting from 0 like the columns in the parse errors.
+func OffsetToLineCol(input []byte, offset int) (line int, col int) {
+	return SourceMap{lineStarts: lineStarts(input)}.OffsetToLineCol(offset)
+}
+
+// Edit represents the replacement of a part of the input commit message with a text.
+type Edit struct {
+	Span Span
+	Text string
+}
+
+// EditDescription returns the edit replacing the description with the given one.
+func (s SourceMap) EditDescription(description string) Edit {
+	return Edit{Span: s.Description, Text: description}
+}
+
+// EditFooterValue returns the edit replacing the value of the footer trailer at the given index with the given one.
+func (s SourceMap) EditFooterValue(i int, value string) Edit {
+	return Edit{Span: s.Footers[i].ValueSpan, Text: value}
+}
+
+// Splice applies the given edits to a copy of the input, leaving the rest of it untouched byte by byte.
+//
+// Unlike rendering the commit message again, it preserves the original formatting.
+// It returns an error when the edits overlap or exceed the input.
+func Splice(input []byte, edits ...Edit) ([]byte, error) {
+	sorted := append([]Edit{}, edits...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Span.Start < sorted[j].Span.Start
+	})
+
+	out := make([]byte, 0, len(input))
+	last := 0
+	for _, e := range sorted {
+		if e.Span.Start < last || e.Span.End < e.Span.Start || e.Span.End > len(input) {
+			return nil, fmt.Errorf("invalid edit of [%d, %d): overlapping or out of the input", e.Span.Start, e.Span.End)
+		}
+		out = append(out, input[last:e.Span.Start]...)
+		out = append(out, e.Text...)
+		last = e.Span.End
+	}
+
+	return append(out, input[last:]...), nil
+}
+
+func lineStarts(input []byte) []int {
+	starts := []int{0}
+	for off := 0; ; {
+		i := bytes.IndexByte(input[off:], '\n')
+		if i < 0 {
+			return starts
+		}
+		off += i + 1
+		starts = append(starts, off)
+	}
+}
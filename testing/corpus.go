@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// corpus contains the example commit messages, valid or invalid with the conventional types, one per file.
+//
+//go:embed corpus
+var corpus embed.FS
+
+// Example is a commit message of the corpus.
+type Example struct {
+	// Name identifies the example (eg., "valid-with-scope").
+	Name string
+	// Message is the commit message.
+	Message []byte
+	// Valid tells whether the commit message is valid with the conventional types.
+	Valid bool
+}
+
+// Corpus returns the file system of the example commit messages,
+// made of a "valid" and an "invalid" directory containing a file per commit message.
+func Corpus() fs.FS {
+	sub, _ := fs.Sub(corpus, "corpus")
+	return sub
+}
+
+// Examples returns all the example commit messages, sorted by name.
+func Examples() []Example {
+	return append(examples("valid", true), examples("invalid", false)...)
+}
+
+// ValidExamples returns the example commit messages valid with the conventional types, sorted by name.
+func ValidExamples() []Example {
+	return examples("valid", true)
+}
+
+// InvalidExamples returns the example commit messages invalid with the conventional types, sorted by name.
+func InvalidExamples() []Example {
+	return examples("invalid", false)
+}
+
+func examples(dir string, valid bool) []Example {
+	entries, _ := fs.ReadDir(corpus, path.Join("corpus", dir))
+	out := make([]Example, 0, len(entries))
+	for _, e := range entries {
+		message, _ := fs.ReadFile(corpus, path.Join("corpus", dir, e.Name()))
+		out = append(out, Example{Name: strings.TrimSuffix(e.Name(), ".txt"), Message: message, Valid: valid})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out
+}
@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// Profile describes the commit messages Generate synthesizes.
+//
+// The counts are maximums: every commit message gets a random number of them, up to the maximum.
+type Profile struct {
+	// Name identifies the profile (eg., "header-only").
+	Name string
+	// Paragraphs is the maximum number of paragraphs of the body.
+	Paragraphs int
+	// Lines is the maximum number of lines of the paragraphs of the body.
+	Lines int
+	// Trailers is the maximum number of footer trailers.
+	Trailers int
+	// Unicode tells whether the words are mostly made of non-ASCII characters.
+	Unicode bool
+	// Scoped is the ratio of commit messages with a scope, between 0 and 1.
+	Scoped float64
+	// Breaking is the ratio of breaking changes, between 0 and 1.
+	Breaking float64
+}
+
+var (
+	// ProfileHeaderOnly synthesizes commit messages made of the header only, like most of the ones of small projects.
+	ProfileHeaderOnly = Profile{Name: "header-only", Scoped: 0.5, Breaking: 0.05}
+	// ProfileLongBodies synthesizes commit messages explaining the change at length.
+	ProfileLongBodies = Profile{Name: "long-bodies", Paragraphs: 8, Lines: 12, Trailers: 2, Scoped: 0.5, Breaking: 0.05}
+	// ProfileManyTrailers synthesizes commit messages with many footer trailers, like the ones of Gerrit or of the DCO workflows.
+	ProfileManyTrailers = Profile{Name: "many-trailers", Paragraphs: 1, Lines: 3, Trailers: 24, Scoped: 0.5, Breaking: 0.1}
+	// ProfileUnicodeHeavy synthesizes commit messages written mostly with non-ASCII characters.
+	ProfileUnicodeHeavy = Profile{Name: "unicode-heavy", Paragraphs: 2, Lines: 4, Trailers: 2, Unicode: true, Scoped: 0.5, Breaking: 0.05}
+)
+
+// Profiles are the predefined profiles.
+var Profiles = []Profile{ProfileHeaderOnly, ProfileLongBodies, ProfileManyTrailers, ProfileUnicodeHeavy}
+
+var (
+	generatedTypes  = []string{"feat", "fix", "docs", "refactor", "test", "chore", "ci", "perf", "build", "style", "revert"}
+	generatedScopes = []string{"api", "cli", "parser", "docs", "deps", "core", "ui"}
+	asciiWords      = []string{"add", "remove", "parse", "handle", "empty", "payloads", "cache", "timeout", "retry", "config", "users", "request", "the", "of", "and", "on", "in", "error", "footer", "release"}
+	unicodeWords    = []string{"修复", "解析器", "缓存", "エラー", "処理", "café", "naïve", "größe", "ошибка", "запрос", "πρόσβαση", "🚀", "✨", "résumé", "خطأ"}
+	generatedTokens = []string{"Refs", "Reviewed-by", "Acked-by", "Signed-off-by", "Change-Id", "Co-authored-by", "Tested-by", "Closes"}
+)
+
+// Generate synthesizes n commit messages following the given profile, all valid with the conventional types.
+//
+// The same seed always gives the same commit messages, so that the benchmarks are repeatable.
+func Generate(p Profile, n int, seed int64) [][]byte {
+	r := rand.New(rand.NewSource(seed))
+	words := asciiWords
+	if p.Unicode {
+		words = unicodeWords
+	}
+	sentence := func(min, max int) string {
+		n := min + r.Intn(max-min+1)
+		s := make([]string, n)
+		for i := range s {
+			s[i] = words[r.Intn(len(words))]
+		}
+		return strings.Join(s, " ")
+	}
+
+	out := make([][]byte, n)
+	for i := range out {
+		var b strings.Builder
+		b.WriteString(generatedTypes[r.Intn(len(generatedTypes))])
+		if r.Float64() < p.Scoped {
+			b.WriteString("(" + generatedScopes[r.Intn(len(generatedScopes))] + ")")
+		}
+		breaking := r.Float64() < p.Breaking
+		if breaking {
+			b.WriteString("!")
+		}
+		b.WriteString(": " + sentence(2, 8))
+
+		for j := count(r, p.Paragraphs); j > 0; j-- {
+			b.WriteString("\n\n" + sentence(3, 12))
+			for k := count(r, p.Lines) - 1; k > 0; k-- {
+				b.WriteString("\n" + sentence(3, 12))
+			}
+		}
+
+		trailers := count(r, p.Trailers)
+		if breaking && trailers > 0 {
+			b.WriteString("\n\nBREAKING CHANGE: " + sentence(3, 8))
+			trailers--
+		} else if trailers > 0 {
+			b.WriteString("\n")
+		}
+		for ; trailers > 0; trailers-- {
+			b.WriteString("\n" + generatedTokens[r.Intn(len(generatedTokens))] + ": " + sentence(1, 4))
+		}
+
+		out[i] = []byte(b.String())
+	}
+
+	return out
+}
+
+// count returns a random number between 1 and max, or 0 when max is 0.
+func count(r *rand.Rand, max int) int {
+	if max <= 0 {
+		return 0
+	}
+	return 1 + r.Intn(max)
+}
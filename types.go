@@ -0,0 +1,143 @@
+package conventionalcommits
+
+import (
+	"strings"
+)
+
+// TypeDefinition describes a commit message type.
+type TypeDefinition struct {
+	// Name is the canonical name of the type, the one the parser outputs.
+	Name string
+	// Description tells what the type is for.
+	Description string
+	// Aliases are alternative names the parser accepts for the type.
+	Aliases []string
+}
+
+// TypeRegistry represents an extensible set of commit message types.
+//
+// Differently from TypeConfig, it lets users choose at runtime the types the parser accepts,
+// their aliases, and whether they are case sensitive or not.
+type TypeRegistry struct {
+	definitions     []TypeDefinition
+	caseInsensitive bool
+}
+
+// NewTypeRegistry creates a case sensitive registry containing the given type definitions.
+func NewTypeRegistry(definitions ...TypeDefinition) *TypeRegistry {
+	return (&TypeRegistry{}).Register(definitions...)
+}
+
+// NewTypeRegistryOf creates a case sensitive registry containing the types with the given names.
+func NewTypeRegistryOf(names ...string) *TypeRegistry {
+	r := NewTypeRegistry()
+	for _, n := range names {
+		r.Register(TypeDefinition{Name: n})
+	}
+	return r
+}
+
+// Register adds the given type definitions to the receiving registry.
+func (r *TypeRegistry) Register(definitions ...TypeDefinition) *TypeRegistry {
+	r.definitions = append(r.definitions, definitions...)
+	return r
+}
+
+// Clone returns a copy of the receiving registry.
+func (r *TypeRegistry) Clone() *TypeRegistry {
+	return &TypeRegistry{
+		definitions:     r.Definitions(),
+		caseInsensitive: r.caseInsensitive,
+	}
+}
+
+// CaseInsensitive makes the receiving registry match types regardless of their case.
+func (r *TypeRegistry) CaseInsensitive() *TypeRegistry {
+	r.caseInsensitive = true
+	return r
+}
+
+// IsCaseInsensitive tells whether the receiving registry matches types regardless of their case.
+func (r *TypeRegistry) IsCaseInsensitive() bool {
+	return r.caseInsensitive
+}
+
+// Definitions returns the type definitions of the receiving registry.
+func (r *TypeRegistry) Definitions() []TypeDefinition {
+	return append([]TypeDefinition{}, r.definitions...)
+}
+
+// Tokens returns the names and the aliases the receiving registry accepts.
+func (r *TypeRegistry) Tokens() []string {
+	tokens := []string{}
+	for _, d := range r.definitions {
+		tokens = append(tokens, d.Name)
+		tokens = append(tokens, d.Aliases...)
+	}
+	return tokens
+}
+
+// Lookup returns the definition of the type whose name or alias is t, according to the casing policy.
+func (r *TypeRegistry) Lookup(t string) (TypeDefinition, bool) {
+	for _, d := range r.definitions {
+		if r.Equal(d.Name, t) {
+			return d, true
+		}
+		for _, a := range d.Aliases {
+			if r.Equal(a, t) {
+				return d, true
+			}
+		}
+	}
+	return TypeDefinition{}, false
+}
+
+// Equal tells whether the given types are the same according to the casing policy of the receiving registry.
+func (r *TypeRegistry) Equal(a, b string) bool {
+	if r.caseInsensitive {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+var minimalTypeDefinitions = []TypeDefinition{
+	{Name: "feat", Description: "A new feature"},
+	{Name: "fix", Description: "A bug fix"},
+}
+
+var conventionalTypeDefinitions = []TypeDefinition{
+	{Name: "build", Description: "Changes that affect the build system or external dependencies"},
+	{Name: "chore", Description: "Other changes that don't modify source or test files"},
+	{Name: "ci", Description: "Changes to the CI configuration files and scripts"},
+	{Name: "docs", Description: "Documentation only changes"},
+	{Name: "feat", Description: "A new feature"},
+	{Name: "fix", Description: "A bug fix"},
+	{Name: "perf", Description: "A code change that improves performance"},
+	{Name: "refactor", Description: "A code change that neither fixes a bug nor adds a feature"},
+	{Name: "revert", Description: "Reverts a previous commit"},
+	{Name: "style", Description: "Changes that do not affect the meaning of the code"},
+	{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+}
+
+// Registry returns a registry containing the types of the receiving set.
+//
+// It returns nil for the free-form set, since it accepts any type, and an empty registry for the unknown sets.
+// The registries of the presets registered at runtime are copies made once, when registering them,
+// which the parsers share: clone them before modifying them.
+func (t TypeConfig) Registry() *TypeRegistry {
+	switch t {
+	case TypesMinimal:
+		return NewTypeRegistry(minimalTypeDefinitions...).CaseInsensitive()
+	case TypesConventional:
+		return NewTypeRegistry(conventionalTypeDefinitions...).CaseInsensitive()
+	case TypesFreeForm:
+		return nil
+	}
+
+	presets.RLock()
+	defer presets.RUnlock()
+	if r, ok := presets.registries[t]; ok {
+		return r
+	}
+	return NewTypeRegistry()
+}
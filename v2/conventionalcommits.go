@@ -0,0 +1,106 @@
+// Package conventionalcommits is the stable surface of this library: the commit messages, the parse results, and their diagnostics.
+//
+// Its types are aliases of the ones of the v1 module, so that values flow between the importers of both,
+// while the finite-state machines stay an implementation detail of the parser package.
+package conventionalcommits
+
+import (
+	v1 "github.com/reviewpad/go-conventionalcommits"
+)
+
+type (
+	// Message represents a conventional commit message.
+	Message = v1.Message
+	// ConventionalCommit represents a commit message as per Conventional Commits specification.
+	ConventionalCommit = v1.ConventionalCommit
+	// Footer is a footer trailer of a commit message.
+	Footer = v1.Footer
+	// Result is the outcome of parsing a commit message.
+	Result = v1.Result
+	// Completeness tells how much of the input a parse result covers.
+	Completeness = v1.Completeness
+	// Diagnostic represents an issue found in a commit message.
+	Diagnostic = v1.Diagnostic
+	// Severity represents how serious a diagnostic is.
+	Severity = v1.Severity
+	// Span represents the byte range [Start, End) of a part of the input commit message.
+	Span = v1.Span
+	// Position represents a position in the input commit message.
+	Position = v1.Position
+	// SourceMap tells where the parts of a parsed commit message are in the input.
+	SourceMap = v1.SourceMap
+	// FooterSpan represents the position of a footer trailer in the input commit message.
+	FooterSpan = v1.FooterSpan
+	// TypeConfig represents the set of types the parser should use.
+	TypeConfig = v1.TypeConfig
+	// TypeDefinition describes a commit message type.
+	TypeDefinition = v1.TypeDefinition
+	// TypeRegistry represents an extensible set of commit message types.
+	TypeRegistry = v1.TypeRegistry
+	// TrivialDescriptionRule tells which descriptions carry too little information.
+	TrivialDescriptionRule = v1.TrivialDescriptionRule
+	// DescriptionCase represents the policies about the case of the first letter of the description.
+	DescriptionCase = v1.DescriptionCase
+	// DuplicateFooterPolicy represents the policies about the footer trailers repeating a token.
+	DuplicateFooterPolicy = v1.DuplicateFooterPolicy
+	// FooterKeyCase represents the forms of the footer trailer tokens in the ordered footers of the commit messages.
+	FooterKeyCase = v1.FooterKeyCase
+	// DescriptionSeparator represents the white-space characters which can separate the colon from the description.
+	DescriptionSeparator = v1.DescriptionSeparator
+	// ErrorInfo is what the custom formatters of the errors receive.
+	ErrorInfo = v1.ErrorInfo
+	// ErrorFormatter renders the errors of the parser.
+	ErrorFormatter = v1.ErrorFormatter
+	// Postprocessor enriches or mutates the messages the parser returns.
+	Postprocessor = v1.Postprocessor
+)
+
+const (
+	CompletenessNone       = v1.CompletenessNone
+	CompletenessHeaderOnly = v1.CompletenessHeaderOnly
+	CompletenessPartial    = v1.CompletenessPartial
+	CompletenessFull       = v1.CompletenessFull
+
+	SeverityError   = v1.SeverityError
+	SeverityWarning = v1.SeverityWarning
+	SeverityInfo    = v1.SeverityInfo
+
+	TypesMinimal      = v1.TypesMinimal
+	TypesConventional = v1.TypesConventional
+	TypesFreeForm     = v1.TypesFreeForm
+
+	CaseAny        = v1.CaseAny
+	CaseLowerFirst = v1.CaseLowerFirst
+	CaseSentence   = v1.CaseSentence
+
+	DuplicateFootersAppend    = v1.DuplicateFootersAppend
+	DuplicateFootersError     = v1.DuplicateFootersError
+	DuplicateFootersFirstWins = v1.DuplicateFootersFirstWins
+	DuplicateFootersLastWins  = v1.DuplicateFootersLastWins
+
+	FooterKeyLower     = v1.FooterKeyLower
+	FooterKeyPreserve  = v1.FooterKeyPreserve
+	FooterKeyTrainCase = v1.FooterKeyTrainCase
+
+	SeparatorSpaces      = v1.SeparatorSpaces
+	SeparatorSpacesOrTab = v1.SeparatorSpacesOrTab
+	SeparatorWhitespace  = v1.SeparatorWhitespace
+)
+
+// DefaultTrivialDescriptionRule flags the most common trivial descriptions and the single-word ones.
+var DefaultTrivialDescriptionRule = v1.DefaultTrivialDescriptionRule
+
+// NewTypeRegistry creates a case sensitive registry containing the given type definitions.
+func NewTypeRegistry(definitions ...TypeDefinition) *TypeRegistry {
+	return v1.NewTypeRegistry(definitions...)
+}
+
+// NewTypeRegistryOf creates a case sensitive registry containing the types with the given names.
+func NewTypeRegistryOf(names ...string) *TypeRegistry {
+	return v1.NewTypeRegistryOf(names...)
+}
+
+// NewDiagnostic converts an error into a diagnostic with the given severity.
+func NewDiagnostic(err error, severity Severity) Diagnostic {
+	return v1.NewDiagnostic(err, severity)
+}
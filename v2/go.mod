@@ -0,0 +1,21 @@
+module github.com/reviewpad/go-conventionalcommits/v2
+
+go 1.18
+
+// v2 adapts the first v1 release carrying the stable API: tag it before tagging v2
+require github.com/reviewpad/go-conventionalcommits v0.12.0
+
+require (
+	github.com/sirupsen/logrus v1.9.0
+	github.com/stretchr/testify v1.8.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// Within this repository, v2 builds against the v1 module next to it
+replace github.com/reviewpad/go-conventionalcommits => ../
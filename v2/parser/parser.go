@@ -0,0 +1,219 @@
+// Package parser is the stable entry point to parse Conventional Commits.
+//
+// It adapts the parser of the v1 module, whose machines and configuration are not part of the stable surface.
+package parser
+
+import (
+	"regexp"
+
+	v1conventionalcommits "github.com/reviewpad/go-conventionalcommits"
+	v1 "github.com/reviewpad/go-conventionalcommits/parser"
+	"github.com/reviewpad/go-conventionalcommits/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Option configures the parsers.
+//
+// Differently from the options of the v1 module, it does not expose the machines it configures.
+type Option struct {
+	machineOption v1conventionalcommits.MachineOption
+}
+
+// machineOptions unwraps the given options into the ones of the v1 parser.
+func machineOptions(options []Option) []v1conventionalcommits.MachineOption {
+	out := make([]v1conventionalcommits.MachineOption, 0, len(options))
+	for _, o := range options {
+		if o.machineOption != nil {
+			out = append(out, o.machineOption)
+		}
+	}
+	return out
+}
+
+// Parser parses Conventional Commits.
+//
+// The given options override the configuration of the parser for a single parsing.
+type Parser interface {
+	Parse(input []byte, options ...Option) (conventionalcommits.Message, error)
+	ParseResult(input []byte, options ...Option) conventionalcommits.Result
+}
+
+// parser adapts the parser of the v1 module.
+type parser struct {
+	p *v1.Parser
+}
+
+// NewParser creates a parser, safe for concurrent use, configured with the given options.
+func NewParser(options ...Option) Parser {
+	return &parser{p: v1.NewParser(machineOptions(options)...)}
+}
+
+// Parse parses the input as a Conventional Commit message.
+func (p *parser) Parse(input []byte, options ...Option) (conventionalcommits.Message, error) {
+	return p.p.Parse(input, machineOptions(options)...)
+}
+
+// ParseResult parses the input as a Conventional Commit message, telling the positions of its parts and its warnings too.
+func (p *parser) ParseResult(input []byte, options ...Option) conventionalcommits.Result {
+	return p.p.ParseResult(input, machineOptions(options)...)
+}
+
+// WithBestEffort enables the best effort mode.
+func WithBestEffort() Option {
+	return Option{v1.WithBestEffort()}
+}
+
+// WithTypes lets you choose the types.
+func WithTypes(t conventionalcommits.TypeConfig) Option {
+	return Option{v1.WithTypes(t)}
+}
+
+// WithTypeRegistry lets you choose the types, their aliases, and their casing at runtime.
+func WithTypeRegistry(r *conventionalcommits.TypeRegistry) Option {
+	return Option{v1.WithTypeRegistry(r)}
+}
+
+// WithCustomTypes restricts the types to the given ones.
+func WithCustomTypes(types []string) Option {
+	return Option{v1.WithCustomTypes(types)}
+}
+
+// WithTypePattern accepts the types matching the given regular expression.
+func WithTypePattern(re *regexp.Regexp) Option {
+	return Option{v1.WithTypePattern(re)}
+}
+
+// WithCaseInsensitiveTypes matches the types regardless of their case.
+func WithCaseInsensitiveTypes() Option {
+	return Option{v1.WithCaseInsensitiveTypes()}
+}
+
+// WithHeaderLimit rejects the inputs whose first line is longer than n bytes before parsing them.
+func WithHeaderLimit(n int) Option {
+	return Option{v1.WithHeaderLimit(n)}
+}
+
+// WithRewindBudget sets how many bytes in total the parser can backtrack over.
+func WithRewindBudget(n int) Option {
+	return Option{v1.WithRewindBudget(n)}
+}
+
+// WithRequiredScope makes the scope mandatory.
+func WithRequiredScope() Option {
+	return Option{v1.WithRequiredScope()}
+}
+
+// WithNoScopeWhitespace rejects the scopes containing whitespace.
+func WithNoScopeWhitespace() Option {
+	return Option{v1.WithNoScopeWhitespace()}
+}
+
+// WithStrictSpec enforces the MUSTs of the specification the parser is otherwise permissive about.
+func WithStrictSpec() Option {
+	return Option{v1.WithStrictSpec()}
+}
+
+// WithTrivialDescriptionRule flags the descriptions carrying too little information.
+func WithTrivialDescriptionRule(r conventionalcommits.TrivialDescriptionRule) Option {
+	return Option{v1.WithTrivialDescriptionRule(r)}
+}
+
+// WithMaxHeaderLength limits the first line of the commit messages to n characters.
+func WithMaxHeaderLength(n int) Option {
+	return Option{v1.WithMaxHeaderLength(n)}
+}
+
+// WithNoTrailingPeriod rejects the descriptions ending with a period.
+func WithNoTrailingPeriod() Option {
+	return Option{v1.WithNoTrailingPeriod()}
+}
+
+// WithDescriptionCase enforces the case of the first character of the description, when it is a letter.
+func WithDescriptionCase(c conventionalcommits.DescriptionCase) Option {
+	return Option{v1.WithDescriptionCase(c)}
+}
+
+// WithDuplicateFooterPolicy chooses what to do with the footer trailers repeating a token.
+func WithDuplicateFooterPolicy(p conventionalcommits.DuplicateFooterPolicy) Option {
+	return Option{v1.WithDuplicateFooterPolicy(p)}
+}
+
+// WithRequiredChangeID requires a valid Change-Id footer trailer.
+func WithRequiredChangeID() Option {
+	return Option{v1.WithRequiredChangeID()}
+}
+
+// WithAllowedFooters rejects the footer trailers whose tokens are not among the given ones.
+func WithAllowedFooters(tokens []string) Option {
+	return Option{v1.WithAllowedFooters(tokens)}
+}
+
+// WithStyleWarnings reports the style issues as warnings rather than as errors.
+func WithStyleWarnings() Option {
+	return Option{v1.WithStyleWarnings()}
+}
+
+// WithMultipleScopes splits the scope on commas and slashes.
+func WithMultipleScopes() Option {
+	return Option{v1.WithMultipleScopes()}
+}
+
+// WithScopePath splits the scope on slashes.
+func WithScopePath() Option {
+	return Option{v1.WithScopePath()}
+}
+
+// WithScopeNormalization trims the whitespace around the scope.
+func WithScopeNormalization() Option {
+	return Option{v1.WithScopeNormalization()}
+}
+
+// WithFooterKeyCase chooses the form of the footer trailer tokens in the ordered footers.
+func WithFooterKeyCase(c conventionalcommits.FooterKeyCase) Option {
+	return Option{v1.WithFooterKeyCase(c)}
+}
+
+// WithLenientColonSpace accepts a missing space after the colon, with a warning.
+func WithLenientColonSpace() Option {
+	return Option{v1.WithLenientColonSpace()}
+}
+
+// WithLenientBlankLine accepts a body on the line right after the description, with a warning.
+func WithLenientBlankLine() Option {
+	return Option{v1.WithLenientBlankLine()}
+}
+
+// WithTrimTrailingWhitespace ignores the trailing blank lines of the input, and the trailing whitespace of its lines.
+func WithTrimTrailingWhitespace() Option {
+	return Option{v1.WithTrimTrailingWhitespace()}
+}
+
+// WithSkipBOM skips a leading byte order mark.
+func WithSkipBOM() Option {
+	return Option{v1.WithSkipBOM()}
+}
+
+// WithDescriptionSeparator chooses the white-space characters which can separate the colon from the description.
+func WithDescriptionSeparator(s conventionalcommits.DescriptionSeparator) Option {
+	return Option{v1.WithDescriptionSeparator(s)}
+}
+
+// WithMultilineFooters folds the continuation lines into the footer trailer values.
+func WithMultilineFooters() Option {
+	return Option{v1.WithMultilineFooters()}
+}
+
+// WithErrorFormatter renders the errors with the given formatter.
+func WithErrorFormatter(f conventionalcommits.ErrorFormatter) Option {
+	return Option{v1.WithErrorFormatter(f)}
+}
+
+// WithPostprocessor runs the given postprocessor on the messages the parser returns.
+func WithPostprocessor(p conventionalcommits.Postprocessor) Option {
+	return Option{v1.WithPostprocessor(p)}
+}
+
+// WithLogger enables a logger during parsing.
+func WithLogger(l *logrus.Logger) Option {
+	return Option{v1.WithLogger(l)}
+}
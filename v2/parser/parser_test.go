@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/go-conventionalcommits/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser(t *testing.T) {
+	p := NewParser(WithTypes(conventionalcommits.TypesConventional))
+
+	m, err := p.Parse([]byte("fix(api): correct typo\n\nRefs: #1"))
+	assert.Nil(t, err)
+	c := m.(*conventionalcommits.ConventionalCommit)
+	assert.Equal(t, "fix", c.Type)
+	assert.Equal(t, []string{"#1"}, c.Footer("Refs"))
+
+	_, err = p.Parse([]byte("fiz: typo"))
+	assert.Error(t, err)
+
+	// The options override the configuration for a single parsing
+	res := p.ParseResult([]byte("fix: Correct typo."), WithNoTrailingPeriod(), WithStyleWarnings())
+	assert.True(t, res.Ok())
+	assert.Len(t, res.Warnings, 1)
+	assert.Equal(t, conventionalcommits.Span{Start: 0, End: 3}, res.SourceMap.Type)
+
+	_, err = p.Parse([]byte("fix: Correct typo"), WithDescriptionCase(conventionalcommits.CaseLowerFirst))
+	assert.Error(t, err)
+	_, err = p.Parse([]byte("fix: Correct typo"))
+	assert.Nil(t, err)
+
+	// The zero option does nothing
+	_, err = NewParser(Option{}).Parse([]byte("feat: x"))
+	assert.Nil(t, err)
+}
+
+func TestOptions(t *testing.T) {
+	// Every option configures the parser without panicking
+	options := []Option{
+		WithBestEffort(),
+		WithTypes(conventionalcommits.TypesFreeForm),
+		WithTypeRegistry(conventionalcommits.NewTypeRegistryOf("fix")),
+		WithCustomTypes([]string{"fix"}),
+		WithCaseInsensitiveTypes(),
+		WithHeaderLimit(100),
+		WithRewindBudget(100),
+		WithRequiredScope(),
+		WithNoScopeWhitespace(),
+		WithStrictSpec(),
+		WithTrivialDescriptionRule(conventionalcommits.DefaultTrivialDescriptionRule),
+		WithMaxHeaderLength(72),
+		WithNoTrailingPeriod(),
+		WithDescriptionCase(conventionalcommits.CaseLowerFirst),
+		WithDuplicateFooterPolicy(conventionalcommits.DuplicateFootersLastWins),
+		WithAllowedFooters([]string{"Refs"}),
+		WithStyleWarnings(),
+		WithMultipleScopes(),
+		WithScopePath(),
+		WithScopeNormalization(),
+		WithFooterKeyCase(conventionalcommits.FooterKeyPreserve),
+		WithLenientColonSpace(),
+		WithLenientBlankLine(),
+		WithTrimTrailingWhitespace(),
+		WithSkipBOM(),
+		WithDescriptionSeparator(conventionalcommits.SeparatorSpacesOrTab),
+		WithMultilineFooters(),
+		WithErrorFormatter(func(i conventionalcommits.ErrorInfo) string { return fmt.Sprint(i.Diagnostic.Code) }),
+		WithPostprocessor(func(c *conventionalcommits.ConventionalCommit) error { return nil }),
+		WithLogger(nil),
+	}
+	res := NewParser(options...).ParseResult([]byte("fix(api): correct typo\n\nRefs: #1"))
+	assert.True(t, res.Ok())
+
+	_, err := NewParser(WithRequiredChangeID()).Parse([]byte("fix: x"))
+	assert.EqualError(t, err, "expecting a 'Change-Id' footer trailer, got none: col=06")
+}
@@ -0,0 +1,64 @@
+package conventionalcommits
+
+// yamlDiagnostic is the YAML representation of a diagnostic.
+type yamlDiagnostic struct {
+	Code       string `yaml:"code"`
+	Severity   string `yaml:"severity"`
+	Message    string `yaml:"message"`
+	Line       int    `yaml:"line,omitempty"`
+	Column     int    `yaml:"column,omitempty"`
+	Suggestion string `yaml:"suggestion,omitempty"`
+}
+
+// yamlFooter is the YAML representation of a footer trailer.
+type yamlFooter struct {
+	Token string `yaml:"token"`
+	Value string `yaml:"value"`
+}
+
+// yamlResult is the YAML representation of a parse result.
+type yamlResult struct {
+	Ok           bool             `yaml:"ok"`
+	Completeness string           `yaml:"completeness"`
+	Type         string           `yaml:"type,omitempty"`
+	Scope        *string          `yaml:"scope,omitempty"`
+	Breaking     bool             `yaml:"breaking,omitempty"`
+	Description  string           `yaml:"description,omitempty"`
+	Body         *string          `yaml:"body,omitempty"`
+	Footers      []yamlFooter     `yaml:"footers,omitempty"`
+	Diagnostics  []yamlDiagnostic `yaml:"diagnostics,omitempty"`
+}
+
+// MarshalYAML implements the Marshaler interface of the gopkg.in/yaml packages,
+// so that the parse results embed as is into YAML documents, like release metadata files.
+//
+// The footer trailers keep their order, and the errors come before the warnings in the diagnostics.
+func (r Result) MarshalYAML() (interface{}, error) {
+	out := yamlResult{Ok: r.Ok(), Completeness: r.Completeness.String()}
+	if c, ok := r.Message.(*ConventionalCommit); ok && c != nil {
+		out.Type = c.Type
+		out.Scope = c.Scope
+		out.Breaking = c.IsBreakingChange()
+		out.Description = c.Description
+		out.Body = c.Body
+		footers := c.OrderedFooters
+		if len(footers) == 0 {
+			footers = sortedFooters(c.Footers)
+		}
+		for _, f := range footers {
+			out.Footers = append(out.Footers, yamlFooter{Token: f.Token, Value: f.Value})
+		}
+	}
+	for _, d := range r.Diagnostics() {
+		out.Diagnostics = append(out.Diagnostics, yamlDiagnostic{
+			Code:       d.Code,
+			Severity:   d.Severity.String(),
+			Message:    d.Message,
+			Line:       d.Position.Line,
+			Column:     d.Position.Column,
+			Suggestion: d.Suggestion,
+		})
+	}
+
+	return out, nil
+}